@@ -0,0 +1,74 @@
+package timeutils_go
+
+import (
+	"time"
+)
+
+// LeapDayPolicy where a 29 February anniversary falls in a common year
+type LeapDayPolicy int
+
+const (
+	LeapDayMarch1 LeapDayPolicy = iota
+	LeapDayFebruary28
+)
+
+func isLeapYear(year int) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
+
+// anniversaryDay day index like NthDay of the anniversary of the civil date month/day in year
+func anniversaryDay(year int, month time.Month, day int, policy LeapDayPolicy) int {
+	if month == time.February && day == 29 && !isLeapYear(year) {
+		if policy == LeapDayFebruary28 {
+			day = 28
+		} else {
+			month, day = time.March, 1
+		}
+	}
+	return nthDayIn(time.Date(year, month, day, 0, 0, 0, 0, time.UTC), time.UTC)
+}
+
+// AnniversaryIn midnight in loc of the anniversary of since in year, loc default to Asia/Jakarta
+func AnniversaryIn(since time.Time, year int, loc *time.Location, policy LeapDayPolicy) time.Time {
+	loc = locationOrDefault(loc)
+	_, month, day := since.In(loc).Date()
+	return dayStart(anniversaryDay(year, month, day, policy), loc)
+}
+
+// Age full years from birthDate to now in loc, a 29 February birthday turns a year older on 1 March in common years
+func Age(birthDate time.Time, now time.Time, loc *time.Location) int {
+	return AgeWithPolicy(birthDate, now, loc, LeapDayMarch1)
+}
+
+// AgeWithPolicy same as Age with a configurable leap day policy
+func AgeWithPolicy(birthDate time.Time, now time.Time, loc *time.Location, policy LeapDayPolicy) int {
+	loc = locationOrDefault(loc)
+	birthYear, month, day := birthDate.In(loc).Date()
+	nowYear := now.In(loc).Year()
+
+	age := nowYear - birthYear
+	if nthDayIn(now, loc) < anniversaryDay(nowYear, month, day, policy) {
+		age--
+	}
+	return age
+}
+
+// NextAnniversary midnight in loc of the first anniversary of since on or after the calendar day of now
+func NextAnniversary(since time.Time, now time.Time, loc *time.Location, policy LeapDayPolicy) time.Time {
+	loc = locationOrDefault(loc)
+	sinceYear, month, day := since.In(loc).Date()
+
+	year := max(now.In(loc).Year(), sinceYear+1)
+	if nthDayIn(now, loc) > anniversaryDay(year, month, day, policy) {
+		year++
+	}
+	return dayStart(anniversaryDay(year, month, day, policy), loc)
+}
+
+// IsAnniversary now is on an anniversary of since in loc, the day of since itself is not an anniversary
+func IsAnniversary(since time.Time, now time.Time, loc *time.Location, policy LeapDayPolicy) bool {
+	loc = locationOrDefault(loc)
+	sinceYear, month, day := since.In(loc).Date()
+	nowYear := now.In(loc).Year()
+	return nowYear > sinceYear && nthDayIn(now, loc) == anniversaryDay(nowYear, month, day, policy)
+}
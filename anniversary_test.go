@@ -0,0 +1,148 @@
+package timeutils_go_test
+
+import (
+	"testing"
+	"time"
+
+	timeutilsgo "github.com/harryosmar/timeutils-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAge(t *testing.T) {
+	leapling := parseRFC3339("2000-02-29T00:00:00+07:00")
+	testData := []struct {
+		name           string
+		birthDate      time.Time
+		now            time.Time
+		policy         timeutilsgo.LeapDayPolicy
+		expectedResult int
+	}{
+		{
+			name:           "day before birthday",
+			birthDate:      parseRFC3339("1990-06-15T00:00:00+07:00"),
+			now:            parseRFC3339("2023-06-14T23:59:59+07:00"),
+			expectedResult: 32,
+		},
+		{
+			name:           "on birthday",
+			birthDate:      parseRFC3339("1990-06-15T00:00:00+07:00"),
+			now:            parseRFC3339("2023-06-15T00:00:00+07:00"),
+			expectedResult: 33,
+		},
+		{
+			name:           "birthday in jakarta but not yet in UTC",
+			birthDate:      parseRFC3339("1990-06-15T00:00:00+07:00"),
+			now:            parseRFC3339("2023-06-14T18:00:00Z"),
+			expectedResult: 33,
+		},
+		{
+			name:           "born before 1970",
+			birthDate:      parseRFC3339("1955-12-31T00:00:00+07:00"),
+			now:            parseRFC3339("2023-12-30T00:00:00+07:00"),
+			expectedResult: 67,
+		},
+		{
+			name:           "leapling on feb 28 with march 1 policy",
+			birthDate:      leapling,
+			now:            parseRFC3339("2023-02-28T12:00:00+07:00"),
+			expectedResult: 22,
+		},
+		{
+			name:           "leapling on march 1 with march 1 policy",
+			birthDate:      leapling,
+			now:            parseRFC3339("2023-03-01T00:00:00+07:00"),
+			expectedResult: 23,
+		},
+		{
+			name:           "leapling on feb 28 with feb 28 policy",
+			birthDate:      leapling,
+			now:            parseRFC3339("2023-02-28T00:00:00+07:00"),
+			policy:         timeutilsgo.LeapDayFebruary28,
+			expectedResult: 23,
+		},
+		{
+			name:           "leapling on feb 28 of a leap year",
+			birthDate:      leapling,
+			now:            parseRFC3339("2024-02-28T00:00:00+07:00"),
+			policy:         timeutilsgo.LeapDayFebruary28,
+			expectedResult: 23,
+		},
+		{
+			name:           "leapling on feb 29",
+			birthDate:      leapling,
+			now:            parseRFC3339("2024-02-29T00:00:00+07:00"),
+			expectedResult: 24,
+		},
+		{
+			name:           "century is not a leap year",
+			birthDate:      parseRFC3339("1896-02-29T00:00:00+07:00"),
+			now:            parseRFC3339("1900-02-28T00:00:00+07:00"),
+			expectedResult: 3,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := timeutilsgo.AgeWithPolicy(tt.birthDate, tt.now, nil, tt.policy)
+			if actual != tt.expectedResult {
+				t.Errorf("expect %v got %v", tt.expectedResult, actual)
+			}
+		})
+	}
+
+	assert.Equal(t, 22, timeutilsgo.Age(leapling, parseRFC3339("2023-02-28T12:00:00+07:00"), nil))
+}
+
+func TestNextAnniversary(t *testing.T) {
+	since := parseRFC3339("2020-02-29T10:00:00+07:00")
+	testData := []struct {
+		name           string
+		now            time.Time
+		policy         timeutilsgo.LeapDayPolicy
+		expectedResult string
+		expectedIsAnni bool
+	}{
+		{
+			name:           "same day is not an anniversary",
+			now:            since,
+			expectedResult: "2021-03-01T00:00:00+07:00",
+		},
+		{
+			name:           "march 1 policy",
+			now:            parseRFC3339("2022-01-10T00:00:00+07:00"),
+			expectedResult: "2022-03-01T00:00:00+07:00",
+		},
+		{
+			name:           "feb 28 policy",
+			now:            parseRFC3339("2022-01-10T00:00:00+07:00"),
+			policy:         timeutilsgo.LeapDayFebruary28,
+			expectedResult: "2022-02-28T00:00:00+07:00",
+		},
+		{
+			name:           "today is the anniversary",
+			now:            parseRFC3339("2022-02-28T20:00:00+07:00"),
+			policy:         timeutilsgo.LeapDayFebruary28,
+			expectedResult: "2022-02-28T00:00:00+07:00",
+			expectedIsAnni: true,
+		},
+		{
+			name:           "passed this year",
+			now:            parseRFC3339("2022-03-02T00:00:00+07:00"),
+			expectedResult: "2023-03-01T00:00:00+07:00",
+		},
+		{
+			name:           "leap year",
+			now:            parseRFC3339("2024-02-29T00:00:00+07:00"),
+			expectedResult: "2024-02-29T00:00:00+07:00",
+			expectedIsAnni: true,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := timeutilsgo.NextAnniversary(since, tt.now, nil, tt.policy)
+			assert.Equal(t, tt.expectedResult, actual.Format(time.RFC3339))
+			assert.Equal(t, tt.expectedIsAnni, timeutilsgo.IsAnniversary(since, tt.now, nil, tt.policy))
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package timeutils_go
+
+import (
+	"time"
+)
+
+// AppendFormat append Format(p) to dst, the location is cached by LoadLocation and
+// the "2006-01-02 15:04:05" layout is written without time.Format.
+// A dst with enough capacity is not reallocated, the zero time return ErrZeroTime
+func AppendFormat(dst []byte, p FormatParam) ([]byte, error) {
+	if p.T.IsZero() {
+		return dst, ErrZeroTime
+	}
+	if p.Location == "" {
+		p.Location = "Asia/Jakarta"
+	}
+	if p.Format == "" {
+		p.Format = time.RFC3339
+	}
+
+	loc, err := LoadLocation(p.Location)
+	if err != nil {
+		return dst, err
+	}
+	if p.Format == time.DateTime {
+		return appendDateTime(dst, p.T.In(loc)), nil
+	}
+	return p.T.In(loc).AppendFormat(dst, p.Format), nil
+}
+
+// AppendFormatDate append FormatDate(t) to dst
+func AppendFormatDate(dst []byte, t time.Time) ([]byte, error) {
+	return AppendFormat(dst, FormatParam{
+		T:        t,
+		Location: "Asia/Jakarta",
+		Format:   "02 Jan 2006 15:04 MST",
+	})
+}
+
+// AppendFormatMySQLDateJakartaTimezone append FormatMySQLDateJakartaTimezone(t) to dst
+func AppendFormatMySQLDateJakartaTimezone(dst []byte, t time.Time) ([]byte, error) {
+	return AppendFormat(dst, FormatParam{
+		T:        t,
+		Location: "Asia/Jakarta",
+		Format:   time.DateTime,
+	})
+}
+
+// AppendFormatMySQLDateUTCTimezone append FormatMySQLDateUTCTimezone(t) to dst
+func AppendFormatMySQLDateUTCTimezone(dst []byte, t time.Time) ([]byte, error) {
+	return AppendFormat(dst, FormatParam{
+		T:        t,
+		Location: "UTC",
+		Format:   time.DateTime,
+	})
+}
+
+// appendDateTime "2006-01-02 15:04:05" of the wall clock of t, years outside 0..9999 go through time.AppendFormat
+func appendDateTime(dst []byte, t time.Time) []byte {
+	year, month, day := t.Date()
+	if year < 0 || year > 9999 {
+		return t.AppendFormat(dst, time.DateTime)
+	}
+	hour, minute, sec := t.Clock()
+	return append(dst,
+		byte('0'+year/1000), byte('0'+year/100%10), byte('0'+year/10%10), byte('0'+year%10), '-',
+		byte('0'+int(month)/10), byte('0'+int(month)%10), '-',
+		byte('0'+day/10), byte('0'+day%10), ' ',
+		byte('0'+hour/10), byte('0'+hour%10), ':',
+		byte('0'+minute/10), byte('0'+minute%10), ':',
+		byte('0'+sec/10), byte('0'+sec%10),
+	)
+}
@@ -0,0 +1,125 @@
+package timeutils_go_test
+
+import (
+	"testing"
+	"time"
+
+	timeutilsgo "github.com/harryosmar/timeutils-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppendFormat(t *testing.T) {
+	testData := []struct {
+		name   string
+		t      time.Time
+		params timeutilsgo.FormatParam
+	}{
+		{name: "mysql jakarta", t: parseRFC3339("2024-07-01T01:30:05Z"), params: timeutilsgo.FormatParam{Format: time.DateTime}},
+		{name: "mysql utc", t: parseRFC3339("2024-07-01T01:30:05Z"), params: timeutilsgo.FormatParam{Location: "UTC", Format: time.DateTime}},
+		{name: "mysql before 1964 offset", t: parseRFC3339("1955-12-31T00:00:00Z"), params: timeutilsgo.FormatParam{Format: time.DateTime}},
+		{name: "mysql year 1", t: parseRFC3339("0001-01-01T00:00:01Z"), params: timeutilsgo.FormatParam{Location: "UTC", Format: time.DateTime}},
+		{name: "mysql year 999", t: parseRFC3339("0999-09-09T09:09:09Z"), params: timeutilsgo.FormatParam{Location: "UTC", Format: time.DateTime}},
+		{name: "mysql year 9999", t: parseRFC3339("9999-12-31T23:59:59+07:00"), params: timeutilsgo.FormatParam{Format: time.DateTime}},
+		{name: "mysql after year 9999", t: parseRFC3339("9999-12-31T23:59:59Z"), params: timeutilsgo.FormatParam{Location: "WIT", Format: time.DateTime}},
+		{name: "default rfc3339", t: parseRFC3339("2024-07-01T01:30:05Z")},
+		{name: "date", t: parseRFC3339("2024-07-01T01:30:05Z"), params: timeutilsgo.FormatParam{Format: "02 Jan 2006 15:04 MST"}},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			p := tt.params
+			p.T = tt.t
+			expected := tt.t.In(mustLoadLocation(t, p.Location)).Format(defaultLayout(p.Format))
+
+			actual, err := timeutilsgo.AppendFormat([]byte("prefix "), p)
+			assert.NoError(t, err)
+			assert.Equal(t, "prefix "+expected, string(actual))
+
+			s, err := timeutilsgo.Format(p)
+			assert.NoError(t, err)
+			assert.Equal(t, expected, s)
+		})
+	}
+
+	_, err := timeutilsgo.AppendFormat(nil, timeutilsgo.FormatParam{})
+	assert.ErrorIs(t, err, timeutilsgo.ErrZeroTime)
+	_, err = timeutilsgo.AppendFormat(nil, timeutilsgo.FormatParam{T: time.Now(), Location: "Mars/Olympus_Mons"})
+	assert.ErrorIs(t, err, timeutilsgo.ErrUnknownLocation)
+}
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	if name == "" {
+		name = "Asia/Jakarta"
+	}
+	loc, err := timeutilsgo.LoadLocation(name)
+	assert.NoError(t, err)
+	return loc
+}
+
+func defaultLayout(layout string) string {
+	if layout == "" {
+		return time.RFC3339
+	}
+	return layout
+}
+
+func TestAppendFormat_Helpers(t *testing.T) {
+	ts := parseRFC3339("2024-07-01T01:30:05Z")
+	for _, tt := range []struct {
+		name   string
+		append func([]byte, time.Time) ([]byte, error)
+		format func(time.Time) (string, error)
+	}{
+		{name: "date", append: timeutilsgo.AppendFormatDate, format: timeutilsgo.FormatDate},
+		{name: "mysql jakarta", append: timeutilsgo.AppendFormatMySQLDateJakartaTimezone, format: timeutilsgo.FormatMySQLDateJakartaTimezone},
+		{name: "mysql utc", append: timeutilsgo.AppendFormatMySQLDateUTCTimezone, format: timeutilsgo.FormatMySQLDateUTCTimezone},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			expected, err := tt.format(ts)
+			assert.NoError(t, err)
+			actual, err := tt.append(nil, ts)
+			assert.NoError(t, err)
+			assert.Equal(t, expected, string(actual))
+
+			buf := make([]byte, 0, 64)
+			assert.Equal(t, 0.0, testing.AllocsPerRun(100, func() {
+				buf, _ = tt.append(buf[:0], ts)
+			}))
+		})
+	}
+}
+
+func BenchmarkFormatMySQLDateJakartaTimezone(b *testing.B) {
+	ts := parseRFC3339("2024-07-01T01:30:05Z")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = timeutilsgo.FormatMySQLDateJakartaTimezone(ts)
+	}
+}
+
+func BenchmarkAppendFormatMySQLDateJakartaTimezone(b *testing.B) {
+	ts := parseRFC3339("2024-07-01T01:30:05Z")
+	buf := make([]byte, 0, 64)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf, _ = timeutilsgo.AppendFormatMySQLDateJakartaTimezone(buf[:0], ts)
+	}
+}
+
+func BenchmarkTimeFormat_DateTime(b *testing.B) {
+	ts := parseRFC3339("2024-07-01T01:30:05Z")
+	loc, _ := time.LoadLocation("Asia/Jakarta")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = ts.In(loc).Format(time.DateTime)
+	}
+}
+
+func BenchmarkAppendFormatDate(b *testing.B) {
+	ts := parseRFC3339("2024-07-01T01:30:05Z")
+	buf := make([]byte, 0, 64)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf, _ = timeutilsgo.AppendFormatDate(buf[:0], ts)
+	}
+}
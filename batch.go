@@ -0,0 +1,58 @@
+package timeutils_go
+
+import (
+	"time"
+)
+
+// NthDays append NthDay of every t to dst, reuse dst across calls to avoid allocating
+func NthDays(dst []int, ts []time.Time) []int {
+	for _, t := range ts {
+		dst = append(dst, NthDay(t))
+	}
+	return dst
+}
+
+// DayIndexes append DayIndex of every t to dst
+func DayIndexes(dst []int64, ts []time.Time) []int64 {
+	for _, t := range ts {
+		dst = append(dst, DayIndex(t))
+	}
+	return dst
+}
+
+// DayIndexes append the zone DayIndex of every t to dst, counted with the fixed offset like NthDay
+func (z Zone) DayIndexes(dst []int64, ts []time.Time) []int64 {
+	offset := int64(z.Offset)
+	for _, t := range ts {
+		dst = append(dst, floorDiv64(t.Unix()+offset, 86400))
+	}
+	return dst
+}
+
+// FloorDays append the start of the calendar day of every t in the zone IANA location to dst,
+// the same location as Format so days before 1964 start at the historical midnight
+func (z Zone) FloorDays(dst []time.Time, ts []time.Time) []time.Time {
+	return FloorDays(dst, ts, z.IANALocation())
+}
+
+// FloorDays append Floor(t, Day, loc) of every t to dst, loc default to Asia/Jakarta
+func FloorDays(dst []time.Time, ts []time.Time, loc *time.Location) []time.Time {
+	loc = locationOrDefault(loc)
+	for _, t := range ts {
+		dst = append(dst, floorUnit(t, Day, loc))
+	}
+	return dst
+}
+
+// AppendMySQLDates append every t as "2006-01-02 15:04:05" in the zone IANA location to buf, the same output as FormatMySQLDate.
+// ends[i] is where ts[i] end in buf so ts[i] is buf[ends[i-1]:ends[i]]. The zero time is written as an empty entry, reuse buf and ends across calls
+func (z Zone) AppendMySQLDates(buf []byte, ends []int, ts []time.Time) ([]byte, []int) {
+	loc := z.IANALocation()
+	for _, t := range ts {
+		if !t.IsZero() {
+			buf = appendDateTime(buf, t.In(loc))
+		}
+		ends = append(ends, len(buf))
+	}
+	return buf, ends
+}
@@ -0,0 +1,125 @@
+package timeutils_go_test
+
+import (
+	"testing"
+	"time"
+
+	timeutilsgo "github.com/harryosmar/timeutils-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func batchTimes(n int) []time.Time {
+	ts := make([]time.Time, n)
+	start := parseRFC3339("1969-12-30T13:17:00+07:00")
+	for i := range ts {
+		ts[i] = start.Add(time.Duration(i) * 7 * time.Hour)
+	}
+	return ts
+}
+
+func TestBatch(t *testing.T) {
+	// Jakarta was UTC+8 in 1950 and UTC+7:30 in 1960, 1960-06-15 16:45 UTC is already the 16th there
+	ts := append(batchTimes(50), time.Time{}, parseRFC3339("1950-01-01T00:00:00Z"), parseRFC3339("1960-06-15T16:45:00Z"))
+	jakarta := timeutilsgo.WIB.IANALocation()
+
+	days := timeutilsgo.NthDays(nil, ts)
+	indexes := timeutilsgo.DayIndexes(nil, ts)
+	witaIndexes := timeutilsgo.WITA.DayIndexes(nil, ts)
+	floors := timeutilsgo.WIB.FloorDays(nil, ts)
+	floorsIn := timeutilsgo.FloorDays(nil, ts, nil)
+	buf, ends := timeutilsgo.WIB.AppendMySQLDates(nil, nil, ts)
+	assert.Len(t, ends, len(ts))
+
+	start := 0
+	for i, ti := range ts {
+		assert.Equal(t, timeutilsgo.NthDay(ti), days[i])
+		assert.Equal(t, timeutilsgo.DayIndex(ti), indexes[i])
+		assert.Equal(t, timeutilsgo.WITA.DayIndex(ti), witaIndexes[i])
+		assert.Equal(t, timeutilsgo.Floor(ti, timeutilsgo.Day, jakarta), floors[i])
+		assert.Equal(t, timeutilsgo.Floor(ti, timeutilsgo.Day, nil), floorsIn[i])
+
+		expected, err := timeutilsgo.FormatMySQLDateJakartaTimezone(ti)
+		if err != nil {
+			expected = ""
+		}
+		assert.Equal(t, expected, string(buf[start:ends[i]]))
+		start = ends[i]
+	}
+	assert.Equal(t, "1950-01-01 08:00:00", string(buf[ends[len(ts)-3]:ends[len(ts)-2]]))
+	assert.Equal(t, "1960-06-16T00:00:00+07:30", floors[len(ts)-1].Format(time.RFC3339))
+}
+
+func TestBatch_Allocs(t *testing.T) {
+	ts := batchTimes(1000)
+	days := make([]int, 0, len(ts))
+	indexes := make([]int64, 0, len(ts))
+	floors := make([]time.Time, 0, len(ts))
+	buf := make([]byte, 0, len(ts)*19)
+	ends := make([]int, 0, len(ts))
+
+	testData := []struct {
+		name string
+		f    func()
+	}{
+		{name: "NthDays", f: func() { days = timeutilsgo.NthDays(days[:0], ts) }},
+		{name: "DayIndexes", f: func() { indexes = timeutilsgo.DayIndexes(indexes[:0], ts) }},
+		{name: "Zone.DayIndexes", f: func() { indexes = timeutilsgo.WIT.DayIndexes(indexes[:0], ts) }},
+		{name: "Zone.FloorDays", f: func() { floors = timeutilsgo.WIB.FloorDays(floors[:0], ts) }},
+		{name: "FloorDays", f: func() { floors = timeutilsgo.FloorDays(floors[:0], ts, nil) }},
+		{name: "Zone.AppendMySQLDates", f: func() { buf, ends = timeutilsgo.WIB.AppendMySQLDates(buf[:0], ends[:0], ts) }},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, 0.0, testing.AllocsPerRun(10, tt.f))
+		})
+	}
+}
+
+func BenchmarkNthDays(b *testing.B) {
+	ts := batchTimes(1000)
+	days := make([]int, 0, len(ts))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		days = timeutilsgo.NthDays(days[:0], ts)
+	}
+}
+
+func BenchmarkNthDay_PerCall(b *testing.B) {
+	ts := batchTimes(1000)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for _, t := range ts {
+			_, _ = timeutilsgo.GetNthDay(t)
+		}
+	}
+}
+
+func BenchmarkZone_FloorDays(b *testing.B) {
+	ts := batchTimes(1000)
+	floors := make([]time.Time, 0, len(ts))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		floors = timeutilsgo.WIB.FloorDays(floors[:0], ts)
+	}
+}
+
+func BenchmarkZone_AppendMySQLDates(b *testing.B) {
+	ts := batchTimes(1000)
+	buf := make([]byte, 0, len(ts)*19)
+	ends := make([]int, 0, len(ts))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf, ends = timeutilsgo.WIB.AppendMySQLDates(buf[:0], ends[:0], ts)
+	}
+}
+
+func BenchmarkFormatMySQLDateJakartaTimezone_PerCall(b *testing.B) {
+	ts := batchTimes(1000)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for _, t := range ts {
+			_, _ = timeutilsgo.FormatMySQLDateJakartaTimezone(t)
+		}
+	}
+}
@@ -0,0 +1,293 @@
+package timeutils_go
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// ChineseDate a date in the Chinese lunisolar calendar, Year is the Gregorian year in which the lunar year starts (Imlek)
+type ChineseDate struct {
+	Year      int
+	Month     int
+	Day       int
+	LeapMonth bool
+}
+
+var (
+	chineseStems    = [10]string{"Jia", "Yi", "Bing", "Ding", "Wu", "Ji", "Geng", "Xin", "Ren", "Gui"}
+	chineseBranches = [12]string{"Zi", "Chou", "Yin", "Mao", "Chen", "Si", "Wu", "Wei", "Shen", "You", "Xu", "Hai"}
+	chineseZodiac   = map[Locale][12]string{
+		LocaleEnglish:    {"Rat", "Ox", "Tiger", "Rabbit", "Dragon", "Snake", "Horse", "Goat", "Monkey", "Rooster", "Dog", "Pig"},
+		LocaleIndonesian: {"Tikus", "Kerbau", "Macan", "Kelinci", "Naga", "Ular", "Kuda", "Kambing", "Monyet", "Ayam", "Anjing", "Babi"},
+	}
+)
+
+// StemBranch sexagenary name of the year, e.g. "Jia-Chen" for 2024
+func (d ChineseDate) StemBranch() string {
+	return chineseStems[floorMod(d.Year-4, 10)] + "-" + chineseBranches[floorMod(d.Year-4, 12)]
+}
+
+// Zodiac animal of the year, e.g. "Dragon" or "Naga" for 2024
+func (d ChineseDate) Zodiac(locale Locale) string {
+	names, ok := chineseZodiac[locale]
+	if !ok {
+		names = chineseZodiac[LocaleEnglish]
+	}
+	return names[floorMod(d.Year-4, 12)]
+}
+
+// ChineseCalendar Chinese lunisolar calendar computed from new moons and principal solar terms in China Standard Time (UTC+8).
+// A civil date in Location is converted to the Chinese date of the same civil date, Location default to Asia/Jakarta.
+// Supported Gregorian years are 1901..2100, the astronomy is accurate to a few minutes
+// so a new moon or solar term within minutes of midnight may land on the wrong day
+type ChineseCalendar struct {
+	Location *time.Location
+}
+
+// Imlek the Chinese calendar for dates in Asia/Jakarta
+var Imlek = ChineseCalendar{}
+
+var chineseValidation = Validation{MinYear: 1901, MaxYear: 2100}
+
+type chineseMonth struct {
+	// start day index like NthDay of the first day of the month
+	start int
+	year  int
+	month int
+	leap  bool
+}
+
+// Date Chinese date of the calendar day of t
+func (c ChineseCalendar) Date(t time.Time) (ChineseDate, error) {
+	loc := locationOrDefault(c.Location)
+	day := nthDayIn(t, loc)
+	year := t.In(loc).Year()
+	if err := chineseValidation.Year(year); err != nil {
+		return ChineseDate{}, err
+	}
+
+	months := append(chineseSui(year), chineseSui(year+1)...)
+	for i := len(months) - 1; i >= 0; i-- {
+		if m := months[i]; m.start <= day {
+			return ChineseDate{Year: m.year, Month: m.month, Day: day - m.start + 1, LeapMonth: m.leap}, nil
+		}
+	}
+	return ChineseDate{}, fmt.Errorf("%w: %d is before the first supported lunar month", ErrInvalidYear, year)
+}
+
+// Time midnight of the Chinese date d
+func (c ChineseCalendar) Time(d ChineseDate) (time.Time, error) {
+	if err := chineseValidation.Year(d.Year); err != nil {
+		return time.Time{}, err
+	}
+	if err := DefaultValidation.Month(d.Month); err != nil {
+		return time.Time{}, err
+	}
+
+	months := append(chineseSui(d.Year), chineseSui(d.Year+1)...)
+	for i, m := range months[:len(months)-1] {
+		if m.year != d.Year || m.month != d.Month || m.leap != d.LeapMonth {
+			continue
+		}
+		if last := months[i+1].start - m.start; d.Day < 1 || d.Day > last {
+			return time.Time{}, fmt.Errorf("%w: %d is outside 1..%d", ErrInvalidDay, d.Day, last)
+		}
+		return dayStart(m.start+d.Day-1, locationOrDefault(c.Location)), nil
+	}
+	return time.Time{}, fmt.Errorf("%w: %d has no leap month %d", ErrInvalidMonth, d.Year, d.Month)
+}
+
+// NewYear midnight of the first day of the lunar year (Imlek)
+func (c ChineseCalendar) NewYear(year int) (time.Time, error) {
+	return c.Time(ChineseDate{Year: year, Month: 1, Day: 1})
+}
+
+// MonthRange first and last second of the lunar month, the same gte/lte as GetMonthRange
+func (c ChineseCalendar) MonthRange(d ChineseDate) (gte int64, lte int64, err error) {
+	d.Day = 1
+	start, err := c.Time(d)
+	if err != nil {
+		return 0, 0, err
+	}
+	loc := locationOrDefault(c.Location)
+	first := nthDayIn(start, loc)
+	for _, m := range append(chineseSui(d.Year), chineseSui(d.Year+1)...) {
+		if m.start > first {
+			return start.Unix(), dayStart(m.start, loc).Unix() - 1, nil
+		}
+	}
+	return 0, 0, fmt.Errorf("%w: %d", ErrInvalidYear, d.Year)
+}
+
+// chineseSui lunar months from month 11 containing the winter solstice of year-1 up to, not including, month 11 of year.
+// When there are 13 months the first one without a principal solar term is the leap month
+func chineseSui(year int) []chineseMonth {
+	k0 := month11NewMoon(year - 1)
+	k1 := month11NewMoon(year)
+	leapYear := k1-k0 == 13
+
+	months := make([]chineseMonth, 0, 13)
+	number, leapUsed, lunarYear := 10, false, year-1
+	for k := k0; k < k1; k++ {
+		m := chineseMonth{start: newMoonDay(k)}
+		if leapYear && !leapUsed && k > k0 && !hasPrincipalTerm(m.start, newMoonDay(k+1)) {
+			m.leap, leapUsed = true, true
+		} else {
+			number = number%12 + 1
+			if number == 1 {
+				lunarYear = year
+			}
+		}
+		m.month, m.year = number, lunarYear
+		months = append(months, m)
+	}
+	return months
+}
+
+// month11NewMoon lunation number k of the new moon starting the month containing the winter solstice of year
+func month11NewMoon(year int) int {
+	solstice := chinaDay(solarTermJDE(year, 270))
+	k := int(math.Floor((float64(solstice)+unixEpochJDN-0.5-2451550.09766)/29.530588861)) + 1
+	for newMoonDay(k) > solstice {
+		k--
+	}
+	return k
+}
+
+// hasPrincipalTerm the sun longitude crosses a multiple of 30 degrees between the start of day from and the start of day to
+func hasPrincipalTerm(from int, to int) bool {
+	return math.Floor(sunLongitude(chinaMidnightJDE(from))/30) != math.Floor(sunLongitude(chinaMidnightJDE(to))/30)
+}
+
+// chinaDay day index like NthDay of the China Standard Time day containing the instant jde
+func chinaDay(jde float64) int {
+	ut := jde - deltaT(jde)/86400
+	return int(math.Floor(((ut-2440587.5)*86400 + 8*3600) / 86400))
+}
+
+// chinaMidnightJDE julian ephemeris day of midnight China Standard Time of day index day
+func chinaMidnightJDE(day int) float64 {
+	ut := 2440587.5 + (float64(day)*86400-8*3600)/86400
+	return ut + deltaT(ut)/86400
+}
+
+// deltaT TT - UT in seconds, Espenak and Meeus polynomials
+func deltaT(jd float64) float64 {
+	y := 2000 + (jd-2451544.5)/365.2425
+	switch {
+	case y < 1920:
+		t := y - 1900
+		return -2.79 + 1.494119*t - 0.0598939*t*t + 0.0061966*t*t*t - 0.000197*t*t*t*t
+	case y < 1941:
+		t := y - 1920
+		return 21.20 + 0.84493*t - 0.076100*t*t + 0.0020936*t*t*t
+	case y < 1961:
+		t := y - 1950
+		return 29.07 + 0.407*t - t*t/233 + t*t*t/2547
+	case y < 1986:
+		t := y - 1975
+		return 45.45 + 1.067*t - t*t/260 - t*t*t/718
+	case y < 2005:
+		t := y - 2000
+		return 63.86 + 0.3345*t - 0.060374*t*t + 0.0017275*t*t*t + 0.000651814*t*t*t*t + 0.00002373599*t*t*t*t*t
+	case y < 2050:
+		t := y - 2000
+		return 62.92 + 0.32217*t + 0.005589*t*t
+	}
+	u := (y - 1820) / 100
+	return -20 + 32*u*u - 0.5628*(2150-y)
+}
+
+func sinDeg(x float64) float64 {
+	return math.Sin(x * math.Pi / 180)
+}
+
+// sunLongitude apparent geocentric longitude of the sun in degrees (0..360), Meeus chapter 25 low accuracy
+func sunLongitude(jde float64) float64 {
+	t := (jde - 2451545.0) / 36525
+	l0 := 280.46646 + 36000.76983*t + 0.0003032*t*t
+	m := 357.52911 + 35999.05029*t - 0.0001537*t*t
+	c := (1.914602-0.004817*t-0.000014*t*t)*sinDeg(m) + (0.019993-0.000101*t)*sinDeg(2*m) + 0.000289*sinDeg(3*m)
+	omega := 125.04 - 1934.136*t
+	lambda := l0 + c - 0.00569 - 0.00478*sinDeg(omega)
+	return math.Mod(math.Mod(lambda, 360)+360, 360)
+}
+
+// solarTermJDE julian ephemeris day when the sun reaches longitude in year
+func solarTermJDE(year int, longitude float64) float64 {
+	jde := 2451623.8 + 365.2422*float64(year-2000) + longitude/360*365.2422
+	for i := 0; i < 8; i++ {
+		diff := math.Mod(longitude-sunLongitude(jde)+540, 360) - 180
+		jde += diff * 365.2422 / 360
+	}
+	return jde
+}
+
+// newMoonDay day index like NthDay of the China Standard Time day of new moon number k
+func newMoonDay(k int) int {
+	return chinaDay(newMoonJDE(k))
+}
+
+// newMoonJDE julian ephemeris day of new moon number k, k 0 is 2000-01-06, Meeus chapter 49
+func newMoonJDE(k int) float64 {
+	kf := float64(k)
+	t := kf / 1236.85
+	jde := 2451550.09766 + 29.530588861*kf + 0.00015437*t*t - 0.000000150*t*t*t + 0.00000000073*t*t*t*t
+	e := 1 - 0.002516*t - 0.0000074*t*t
+	m := 2.5534 + 29.10535670*kf - 0.0000014*t*t - 0.00000011*t*t*t
+	mp := 201.5643 + 385.81693528*kf + 0.0107582*t*t + 0.00001238*t*t*t - 0.000000058*t*t*t*t
+	f := 160.7108 + 390.67050284*kf - 0.0016118*t*t - 0.00000227*t*t*t + 0.000000011*t*t*t*t
+	omega := 124.7746 - 1.56375588*kf + 0.0020672*t*t + 0.00000215*t*t*t
+
+	jde += -0.40720*sinDeg(mp) +
+		0.17241*e*sinDeg(m) +
+		0.01608*sinDeg(2*mp) +
+		0.01039*sinDeg(2*f) +
+		0.00739*e*sinDeg(mp-m) -
+		0.00514*e*sinDeg(mp+m) +
+		0.00208*e*e*sinDeg(2*m) -
+		0.00111*sinDeg(mp-2*f) -
+		0.00057*sinDeg(mp+2*f) +
+		0.00056*e*sinDeg(2*mp+m) -
+		0.00042*sinDeg(3*mp) +
+		0.00042*e*sinDeg(m+2*f) +
+		0.00038*e*sinDeg(m-2*f) -
+		0.00024*e*sinDeg(2*mp-m) -
+		0.00017*sinDeg(omega) -
+		0.00007*sinDeg(mp+2*m) +
+		0.00004*sinDeg(2*mp-2*f) +
+		0.00004*sinDeg(3*m) +
+		0.00003*sinDeg(mp+m-2*f) +
+		0.00003*sinDeg(2*mp+2*f) -
+		0.00003*sinDeg(mp+m+2*f) +
+		0.00003*sinDeg(mp-m+2*f) -
+		0.00002*sinDeg(mp-m-2*f) -
+		0.00002*sinDeg(3*mp+m) +
+		0.00002*sinDeg(4*mp)
+
+	planetary := [14][3]float64{
+		{299.77, 0.107408, 0.000325},
+		{251.88, 0.016321, 0.000165},
+		{251.83, 26.651886, 0.000164},
+		{349.42, 36.412478, 0.000126},
+		{84.66, 18.206239, 0.000110},
+		{141.74, 53.303771, 0.000062},
+		{207.14, 2.453732, 0.000060},
+		{154.84, 7.306860, 0.000056},
+		{34.52, 27.261239, 0.000047},
+		{207.19, 0.121824, 0.000042},
+		{291.34, 1.844379, 0.000040},
+		{161.72, 24.198154, 0.000037},
+		{239.56, 25.513099, 0.000035},
+		{331.55, 3.592518, 0.000023},
+	}
+	for i, a := range planetary {
+		angle := a[0] + a[1]*kf
+		if i == 0 {
+			angle -= 0.009173 * t * t
+		}
+		jde += a[2] * sinDeg(angle)
+	}
+	return jde
+}
@@ -0,0 +1,104 @@
+package timeutils_go_test
+
+import (
+	"testing"
+	"time"
+
+	timeutilsgo "github.com/harryosmar/timeutils-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChineseCalendar_NewYear(t *testing.T) {
+	testData := []struct {
+		year           int
+		expectedResult string
+	}{
+		{year: 1901, expectedResult: "1901-02-19"},
+		{year: 1985, expectedResult: "1985-02-20"},
+		{year: 2020, expectedResult: "2020-01-25"},
+		{year: 2021, expectedResult: "2021-02-12"},
+		{year: 2022, expectedResult: "2022-02-01"},
+		{year: 2023, expectedResult: "2023-01-22"},
+		{year: 2024, expectedResult: "2024-02-10"},
+		{year: 2025, expectedResult: "2025-01-29"},
+		{year: 2026, expectedResult: "2026-02-17"},
+		{year: 2033, expectedResult: "2033-01-31"},
+		{year: 2034, expectedResult: "2034-02-19"},
+		{year: 2100, expectedResult: "2100-02-09"},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.expectedResult, func(t *testing.T) {
+			actual, err := timeutilsgo.Imlek.NewYear(tt.year)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedResult+"T00:00:00+07:00", actual.Format(time.RFC3339))
+		})
+	}
+
+	_, err := timeutilsgo.Imlek.NewYear(2101)
+	assert.ErrorIs(t, err, timeutilsgo.ErrInvalidYear)
+}
+
+func TestChineseCalendar_Date(t *testing.T) {
+	testData := []struct {
+		name           string
+		t              time.Time
+		expectedResult timeutilsgo.ChineseDate
+	}{
+		{name: "imlek", t: parseRFC3339("2024-02-10T00:00:00+07:00"), expectedResult: timeutilsgo.ChineseDate{Year: 2024, Month: 1, Day: 1}},
+		{name: "eve of imlek", t: parseRFC3339("2024-02-09T23:59:59+07:00"), expectedResult: timeutilsgo.ChineseDate{Year: 2023, Month: 12, Day: 30}},
+		{name: "mid autumn", t: parseRFC3339("2024-09-17T12:00:00+07:00"), expectedResult: timeutilsgo.ChineseDate{Year: 2024, Month: 8, Day: 15}},
+		{name: "leap month 6", t: parseRFC3339("2017-07-23T00:00:00+07:00"), expectedResult: timeutilsgo.ChineseDate{Year: 2017, Month: 6, Day: 1, LeapMonth: true}},
+		{name: "last day of leap month 6", t: parseRFC3339("2017-08-21T00:00:00+07:00"), expectedResult: timeutilsgo.ChineseDate{Year: 2017, Month: 6, Day: 30, LeapMonth: true}},
+		{name: "leap month 4", t: parseRFC3339("2020-05-23T00:00:00+07:00"), expectedResult: timeutilsgo.ChineseDate{Year: 2020, Month: 4, Day: 1, LeapMonth: true}},
+		{name: "leap month 2", t: parseRFC3339("2023-03-22T00:00:00+07:00"), expectedResult: timeutilsgo.ChineseDate{Year: 2023, Month: 2, Day: 1, LeapMonth: true}},
+		{name: "leap month 11", t: parseRFC3339("2033-12-22T00:00:00+07:00"), expectedResult: timeutilsgo.ChineseDate{Year: 2033, Month: 11, Day: 1, LeapMonth: true}},
+		{name: "use jakarta calendar day", t: parseRFC3339("2024-02-09T17:00:00Z"), expectedResult: timeutilsgo.ChineseDate{Year: 2024, Month: 1, Day: 1}},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := timeutilsgo.Imlek.Date(tt.t)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, actual)
+
+			back, err := timeutilsgo.Imlek.Time(actual)
+			assert.NoError(t, err)
+			assert.Equal(t, timeutilsgo.NthDay(tt.t), timeutilsgo.NthDay(back))
+		})
+	}
+}
+
+func TestChineseCalendar_RoundTrip(t *testing.T) {
+	start := parseRFC3339("1901-03-01T00:00:00+07:00")
+	for i := 0; i < 365*199; i += 11 {
+		d := start.AddDate(0, 0, i)
+		date, err := timeutilsgo.Imlek.Date(d)
+		if err != nil {
+			t.Fatal(err)
+		}
+		back, err := timeutilsgo.Imlek.Time(date)
+		if err != nil || !back.Equal(d) {
+			t.Fatalf("expect %v got %v %v", d, back, err)
+		}
+	}
+}
+
+func TestChineseCalendar_MonthRange(t *testing.T) {
+	gte, lte, err := timeutilsgo.Imlek.MonthRange(timeutilsgo.ChineseDate{Year: 2017, Month: 6, LeapMonth: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "2017-07-23T00:00:00+07:00 - 2017-08-21T23:59:59+07:00", formatRange(gte, lte))
+
+	_, _, err = timeutilsgo.Imlek.MonthRange(timeutilsgo.ChineseDate{Year: 2024, Month: 6, LeapMonth: true})
+	assert.ErrorIs(t, err, timeutilsgo.ErrInvalidMonth)
+	_, err = timeutilsgo.Imlek.Time(timeutilsgo.ChineseDate{Year: 2024, Month: 1, Day: 31})
+	assert.ErrorIs(t, err, timeutilsgo.ErrInvalidDay)
+}
+
+func TestChineseDate_Zodiac(t *testing.T) {
+	d := timeutilsgo.ChineseDate{Year: 2024, Month: 1, Day: 1}
+	assert.Equal(t, "Jia-Chen", d.StemBranch())
+	assert.Equal(t, "Dragon", d.Zodiac(timeutilsgo.LocaleEnglish))
+	assert.Equal(t, "Naga", d.Zodiac(timeutilsgo.LocaleIndonesian))
+	assert.Equal(t, "Yi-Si", timeutilsgo.ChineseDate{Year: 2025}.StemBranch())
+}
@@ -0,0 +1,95 @@
+package timeutils_go
+
+import (
+	"fmt"
+	"time"
+)
+
+// GapPolicy how a wall clock skipped by a forward transition is resolved, e.g. 02:30 on a spring forward day
+type GapPolicy int
+
+const (
+	// GapShiftForward move the wall clock forward by the length of the gap, 02:30 become 03:30
+	GapShiftForward GapPolicy = iota
+	// GapError return ErrNonexistentTime
+	GapError
+)
+
+// OverlapPolicy how a wall clock repeated by a backward transition is resolved, e.g. 01:30 on a fall back day
+type OverlapPolicy int
+
+const (
+	// OverlapEarlier the first occurrence, still on the offset before the transition
+	OverlapEarlier OverlapPolicy = iota
+	// OverlapLater the second occurrence, on the offset after the transition
+	OverlapLater
+	// OverlapError return ErrAmbiguousTime
+	OverlapError
+)
+
+// CivilPolicy the zero value shift gaps forward and pick the earlier instant of an overlap
+type CivilPolicy struct {
+	Gap     GapPolicy
+	Overlap OverlapPolicy
+}
+
+// Civil instant at which the wall clock in loc read year-month-day hour:minute:second, loc default to Asia/Jakarta.
+// Date parts are validated by DefaultValidation, nonexistent and repeated wall clocks are resolved by policy
+func Civil(year int, month int, day int, hour int, minute int, second int, loc *time.Location, policy CivilPolicy) (time.Time, error) {
+	if err := DefaultValidation.Date(year, month, day); err != nil {
+		return time.Time{}, err
+	}
+	if hour < 0 || hour > 23 || minute < 0 || minute > 59 || second < 0 || second > 59 {
+		return time.Time{}, fmt.Errorf("%w: %02d:%02d:%02d is outside 00:00:00..23:59:59", ErrInvalidTimeOfDay, hour, minute, second)
+	}
+
+	wall := time.Date(year, time.Month(month), day, hour, minute, second, 0, time.UTC)
+	return resolveWall(wall, locationOrDefault(loc), policy)
+}
+
+// wallTime same as time.Date, out of range parts are normalized, but a gap is shifted forward and an overlap take the earlier instant
+func wallTime(year int, month time.Month, day int, hour int, minute int, sec int, nsec int, loc *time.Location) time.Time {
+	t, _ := resolveWall(time.Date(year, month, day, hour, minute, sec, nsec, time.UTC), loc, CivilPolicy{})
+	return t
+}
+
+// resolveWall instant in loc whose wall clock read the UTC fields of wall.
+// The offsets a day before and after are the only candidates, transitions are assumed to be more than a day apart
+func resolveWall(wall time.Time, loc *time.Location, policy CivilPolicy) (time.Time, error) {
+	w := wall.Unix()
+	before, after := offsetAt(w-86400, loc), offsetAt(w+86400, loc)
+	first, second := w-int64(before), w-int64(after)
+	if before == after {
+		return time.Unix(first, int64(wall.Nanosecond())).In(loc), nil
+	}
+
+	firstOk, secondOk := offsetAt(first, loc) == before, offsetAt(second, loc) == after
+	var u int64
+	switch {
+	case firstOk && secondOk:
+		switch policy.Overlap {
+		case OverlapError:
+			return time.Time{}, fmt.Errorf("%w: %s in %s", ErrAmbiguousTime, wall.Format(time.DateTime), loc)
+		case OverlapLater:
+			u = max(first, second)
+		default:
+			u = min(first, second)
+		}
+	case firstOk:
+		u = first
+	case secondOk:
+		u = second
+	default:
+		if policy.Gap == GapError {
+			return time.Time{}, fmt.Errorf("%w: %s in %s", ErrNonexistentTime, wall.Format(time.DateTime), loc)
+		}
+		// reading the wall clock with the offset before the gap land after it, shifted by the gap length
+		u = first
+	}
+	return time.Unix(u, int64(wall.Nanosecond())).In(loc), nil
+}
+
+func offsetAt(unix int64, loc *time.Location) int {
+	_, offset := time.Unix(unix, 0).In(loc).Zone()
+	return offset
+}
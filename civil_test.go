@@ -0,0 +1,141 @@
+package timeutils_go_test
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata"
+
+	timeutilsgo "github.com/harryosmar/timeutils-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCivil(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+
+	type args struct {
+		year, month, day, hour, minute, second int
+		loc                                    *time.Location
+		policy                                 timeutilsgo.CivilPolicy
+	}
+	testData := []struct {
+		name           string
+		args           args
+		expectedResult string
+		expectedErr    error
+	}{
+		{
+			name:           "default to jakarta",
+			args:           args{year: 2024, month: 3, day: 10, hour: 2, minute: 30},
+			expectedResult: "2024-03-10T02:30:00+07:00",
+		},
+		{
+			name:           "regular wall clock",
+			args:           args{year: 2024, month: 7, day: 1, hour: 9, loc: newYork},
+			expectedResult: "2024-07-01T09:00:00-04:00",
+		},
+		{
+			name:           "gap shift forward",
+			args:           args{year: 2024, month: 3, day: 10, hour: 2, minute: 30, loc: newYork},
+			expectedResult: "2024-03-10T03:30:00-04:00",
+		},
+		{
+			name:        "gap error",
+			args:        args{year: 2024, month: 3, day: 10, hour: 2, minute: 30, loc: newYork, policy: timeutilsgo.CivilPolicy{Gap: timeutilsgo.GapError}},
+			expectedErr: timeutilsgo.ErrNonexistentTime,
+		},
+		{
+			name:           "wall clock right after the gap",
+			args:           args{year: 2024, month: 3, day: 10, hour: 3, loc: newYork, policy: timeutilsgo.CivilPolicy{Gap: timeutilsgo.GapError}},
+			expectedResult: "2024-03-10T03:00:00-04:00",
+		},
+		{
+			name:           "overlap earlier",
+			args:           args{year: 2024, month: 11, day: 3, hour: 1, minute: 30, loc: newYork},
+			expectedResult: "2024-11-03T01:30:00-04:00",
+		},
+		{
+			name:           "overlap later",
+			args:           args{year: 2024, month: 11, day: 3, hour: 1, minute: 30, loc: newYork, policy: timeutilsgo.CivilPolicy{Overlap: timeutilsgo.OverlapLater}},
+			expectedResult: "2024-11-03T01:30:00-05:00",
+		},
+		{
+			name:        "overlap error",
+			args:        args{year: 2024, month: 11, day: 3, hour: 1, minute: 30, loc: newYork, policy: timeutilsgo.CivilPolicy{Overlap: timeutilsgo.OverlapError}},
+			expectedErr: timeutilsgo.ErrAmbiguousTime,
+		},
+		{
+			name:        "invalid day",
+			args:        args{year: 2023, month: 2, day: 29, loc: newYork},
+			expectedErr: timeutilsgo.ErrInvalidDay,
+		},
+		{
+			name:        "invalid time of day",
+			args:        args{year: 2023, month: 2, day: 28, hour: 24, loc: newYork},
+			expectedErr: timeutilsgo.ErrInvalidTimeOfDay,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			a := tt.args
+			actual, err := timeutilsgo.Civil(a.year, a.month, a.day, a.hour, a.minute, a.second, a.loc, a.policy)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, actual.Format(time.RFC3339))
+		})
+	}
+}
+
+func TestCombineDateAndHourIn(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+
+	springForward := time.Date(2024, 3, 10, 0, 0, 0, 0, newYork)
+	actual, err := timeutilsgo.CombineDateAndHourIn(springForward, "02:30:00", newYork, timeutilsgo.CivilPolicy{})
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-03-10T03:30:00-04:00", actual.Format(time.RFC3339))
+
+	_, err = timeutilsgo.CombineDateAndHourIn(springForward, "02:30:00", newYork, timeutilsgo.CivilPolicy{Gap: timeutilsgo.GapError})
+	assert.ErrorIs(t, err, timeutilsgo.ErrNonexistentTime)
+
+	actual, err = timeutilsgo.CombineDateAndHourIn(springForward, "12:00:00", newYork, timeutilsgo.CivilPolicy{})
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-03-10T12:00:00-04:00", actual.Format(time.RFC3339))
+
+	fallBack := time.Date(2024, 11, 3, 0, 0, 0, 0, newYork)
+	actual, err = timeutilsgo.CombineDateAndHourIn(fallBack, "01:30:00", newYork, timeutilsgo.CivilPolicy{Overlap: timeutilsgo.OverlapLater})
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-11-03T01:30:00-05:00", actual.Format(time.RFC3339))
+
+	// 2023-03-28 12:00 UTC is 19:00 on 2023-03-28 in Jakarta
+	actual, err = timeutilsgo.CombineDateAndHourIn(parseRFC3339("2023-03-28T12:00:00Z"), "10:00:00", nil, timeutilsgo.CivilPolicy{})
+	assert.NoError(t, err)
+	assert.Equal(t, "2023-03-28T03:00:00Z", actual.UTC().Format(time.RFC3339))
+}
+
+func TestCombineDateAndHour_AddToInstant(t *testing.T) {
+	// FloorDay return the Jakarta midnight as a time.Unix, the hour is added to that instant whatever its location
+	midnight, err := timeutilsgo.FloorDay(timeutilsgo.FloorDayParam{T: parseRFC3339("2023-03-28T12:00:00Z")})
+	assert.NoError(t, err)
+	actual, err := timeutilsgo.CombineDateAndHour(midnight.UTC(), "10:00:00")
+	assert.NoError(t, err)
+	assert.Equal(t, "2023-03-28T03:00:00Z", actual.UTC().Format(time.RFC3339))
+
+	actual, err = timeutilsgo.CombineDateAndHour(time.Unix(1679936400, 0).UTC(), "23:05:45")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1679936400+23*3600+5*60+45), actual.Unix())
+}
+
+func TestFloor_MidnightGap(t *testing.T) {
+	saoPaulo, err := time.LoadLocation("America/Sao_Paulo")
+	assert.NoError(t, err)
+
+	// 2018-11-04 00:00 did not exist in Sao Paulo, the day started at 01:00
+	noon := time.Date(2018, 11, 4, 12, 0, 0, 0, saoPaulo)
+	assert.Equal(t, "2018-11-04T01:00:00-02:00", timeutilsgo.Floor(noon, timeutilsgo.Day, saoPaulo).Format(time.RFC3339))
+	assert.Equal(t, "2018-11-04T01:00:00-02:00", timeutilsgo.FloorAdd(noon.AddDate(0, 0, -1), timeutilsgo.Day, 1, saoPaulo).Format(time.RFC3339))
+}
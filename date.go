@@ -1,90 +1,63 @@
 package timeutils_go
 
 import (
-	"fmt"
-	"math"
-	"strconv"
 	"time"
 )
 
+// GetMonthRange first and last second of month in Asia/Jakarta, validated by DefaultValidation
 func GetMonthRange(month int, year int) (gte int64, lte int64, err error) {
-	if month < 1 || month > 12 {
-		return lte, gte, fmt.Errorf("invalid month")
-	}
-
-	monthStr := strconv.Itoa(month)
-	if month < 10 {
-		monthStr = fmt.Sprintf("0%s", monthStr)
-	}
-	gteStr := fmt.Sprintf("%d-%s-01T00:00:00+07:00", year, monthStr)
-	gteTime, err := time.Parse(time.RFC3339, gteStr)
-	if err != nil {
-		return lte, gte, err
-	}
-	gte = gteTime.Unix()
-
-	nextMonth := month + 1
-	if nextMonth > 12 {
-		nextMonth = 1
-		year += 1
-	}
-	nextMonthStr := strconv.Itoa(nextMonth)
-	if nextMonth < 10 {
-		nextMonthStr = fmt.Sprintf("0%s", nextMonthStr)
-	}
-	nextMonthTimeStr := fmt.Sprintf("%d-%s-01T00:00:00+07:00", year, nextMonthStr)
-	nextMonthTime, err := time.Parse(time.RFC3339, nextMonthTimeStr)
-	if err != nil {
-		return lte, gte, err
-	}
-
-	lteTime := time.Unix(nextMonthTime.Unix()-1, 0)
-	lte = lteTime.Unix()
-
-	return gte, lte, nil
+	return DefaultValidation.GetMonthRange(month, year)
 }
 
+// DayInUnix number of whole UTC days from 1970-01-01 to t, negative before 1970, see DayIndex
 func DayInUnix(t time.Time) float64 {
-	x := t.Unix()
-	if x%86400 == 0 {
-		return float64(x / 86400)
-	}
-
-	// expect t time.Now calculate n days in Asia/Jakarta UTC+7 hours
-	return math.Ceil(float64(x)/86400) - 1
+	return float64(DayIndex(t))
 }
 
 // DayInUnixJakartaTimezone only used this for time.Now from host
 // DayInUnixJakartaTimezone got time.Now convert to mysql JakartaTimezone
 func DayInUnixJakartaTimezone(t time.Time) float64 {
-	// expect t time.Now calculate n days in Asia/Jakarta UTC+7 hours
-	return DayInUnix(time.Unix(t.Unix()+7*3600, 0))
+	return WIB.DayInUnix(t)
 }
 
+// HourInUnix number of whole hours from 1970-01-01 00:00 UTC to t, negative before 1970, see HourIndex
 func HourInUnix(t time.Time) float64 {
-	x := t.Unix()
-	if x%3600 == 0 {
-		return float64(x / 3600)
-	}
-
-	// expect t time.Now calculate n days in Asia/Jakarta UTC+7 hours
-	return math.Ceil(float64(x)/3600) - 1
+	return float64(HourIndex(t))
 }
 
 // HourInUnixJakartaTimezone only used this for time.Now from host
 // HourInUnixJakartaTimezone got time.Now convert to mysql JakartaTimezone
 func HourInUnixJakartaTimezone(t time.Time) float64 {
-	// expect t time.Now calculate n days in Asia/Jakarta UTC+7 hours
-	return HourInUnix(time.Unix(t.Unix()+7*3600, 0))
+	return WIB.HourInUnix(t)
 }
 
 func GetExpirationTillEndOfTodayJakartaTimezone(t time.Time) int64 {
-	end := (DayInUnixJakartaTimezone(t) + 1) * 86400
-	return int64(end) - t.Unix() - 7*3600
+	return WIB.GetExpirationTillEndOfToday(t)
+}
+
+// DayIndex number of whole UTC days from 1970-01-01 to t, floor division so it is negative before 1970,
+// the int64 version of DayInUnix
+func DayIndex(t time.Time) int64 {
+	return floorDiv64(t.Unix(), 86400)
+}
+
+// HourIndex number of whole hours from 1970-01-01 00:00 UTC to t, the int64 version of HourInUnix
+func HourIndex(t time.Time) int64 {
+	return floorDiv64(t.Unix(), 3600)
+}
+
+// DayIndexJakartaTimezone the int64 version of DayInUnixJakartaTimezone
+func DayIndexJakartaTimezone(t time.Time) int64 {
+	return WIB.DayIndex(t)
+}
+
+// HourIndexJakartaTimezone the int64 version of HourInUnixJakartaTimezone
+func HourIndexJakartaTimezone(t time.Time) int64 {
+	return WIB.HourIndex(t)
 }
 
 func PlusHourToTime(t time.Time, n int64) time.Time {
-	return time.Unix((int64(HourInUnix(t))*3600)+(n*3600), 0)
+	return time.Unix(FloorAdd(t, Hour, int(n), time.UTC).Unix(), 0)
 }
 
 type FormatParam struct {
@@ -93,20 +66,15 @@ type FormatParam struct {
 	Format   string
 }
 
+// Format p.T in p.Location with p.Format, default to Asia/Jakarta and RFC3339.
+// The zero time return ErrZeroTime instead of a "0001-01-01" date, so do FormatDate and FormatMySQLDate*
 func Format(p FormatParam) (string, error) {
-	if p.Location == "" {
-		p.Location = "Asia/Jakarta"
-	}
-
-	if p.Format == "" {
-		p.Format = time.RFC3339
-	}
-
-	jakartaLoc, err := time.LoadLocation(p.Location)
+	var buf [64]byte
+	b, err := AppendFormat(buf[:0], p)
 	if err != nil {
 		return "", err
 	}
-	return p.T.In(jakartaLoc).Format(p.Format), nil
+	return string(b), nil
 }
 
 func FormatDate(t time.Time) (string, error) {
@@ -118,11 +86,7 @@ func FormatDate(t time.Time) (string, error) {
 }
 
 func FormatMySQLDateJakartaTimezone(t time.Time) (string, error) {
-	return Format(FormatParam{
-		T:        t,
-		Location: "Asia/Jakarta",
-		Format:   "2006-01-02 15:04:05",
-	})
+	return WIB.FormatMySQLDate(t)
 }
 
 func FormatMySQLDateUTCTimezone(t time.Time) (string, error) {
@@ -133,10 +97,14 @@ func FormatMySQLDateUTCTimezone(t time.Time) (string, error) {
 	})
 }
 
+// NthDay number of Asia/Jakarta calendar days since 1970-01-01, negative before 1970
+func NthDay(t time.Time) int {
+	return int(WIB.DayIndex(t))
+}
+
+// Deprecated: GetNthDay never fail, use NthDay
 func GetNthDay(t time.Time) (int, error) {
-	u := t.Unix() + (7 * 3600)
-	i := float64(u) / 86400
-	return int(math.Floor(i)), nil
+	return NthDay(t), nil
 }
 
 type FloorDayParam struct {
@@ -144,22 +112,19 @@ type FloorDayParam struct {
 	DRange int
 }
 
+// Deprecated: FloorDay never fail, use FloorAdd(p.T, Day, p.DRange, nil)
 func FloorDay(p FloorDayParam) (time.Time, error) {
-	i, err := GetNthDay(p.T)
-	sec := ((i + p.DRange) * 86400) - (7 * 3600)
-	return time.Unix(int64(sec), 0), err
+	return time.Unix(FloorAdd(p.T, Day, p.DRange, jakartaLocation).Unix(), 0), nil
 }
 
+// DaysBetween number of Asia/Jakarta calendar days from t1 to t2
+func DaysBetween(t1 time.Time, t2 time.Time) int {
+	return NthDay(t2) - NthDay(t1)
+}
+
+// Deprecated: DayDiff never fail, use DaysBetween
 func DayDiff(t1 time.Time, t2 time.Time) (int, error) {
-	nthDay1, err := GetNthDay(t1)
-	if err != nil {
-		return 0, err
-	}
-	nthDay2, err := GetNthDay(t2)
-	if err != nil {
-		return 0, err
-	}
-	return nthDay2 - nthDay1, nil
+	return DaysBetween(t1, t2), nil
 }
 
 type Range struct {
@@ -168,18 +133,14 @@ type Range struct {
 	IsSkipCheck bool
 }
 
-func IsInDayRange(t time.Time, now time.Time, minD Range, maxD Range) (bool, error) {
-	diff, err := DayDiff(t, now)
-	if err != nil {
-		return false, err
-	}
-
+// inRange check minD < diff < maxD, each bound may be inclusive or skipped
+func inRange[F float32 | float64](diff F, minD Range, maxD Range) bool {
 	isMinValid := false
 	if !minD.IsSkipCheck {
 		if minD.IsEqual {
-			isMinValid = diff >= minD.Value
+			isMinValid = diff >= F(minD.Value)
 		} else {
-			isMinValid = diff > minD.Value
+			isMinValid = diff > F(minD.Value)
 		}
 	} else {
 		isMinValid = true
@@ -188,73 +149,47 @@ func IsInDayRange(t time.Time, now time.Time, minD Range, maxD Range) (bool, err
 	isMaxValid := false
 	if !maxD.IsSkipCheck {
 		if maxD.IsEqual {
-			isMaxValid = diff <= maxD.Value
+			isMaxValid = diff <= F(maxD.Value)
 		} else {
-			isMaxValid = diff < maxD.Value
+			isMaxValid = diff < F(maxD.Value)
 		}
 	} else {
 		isMaxValid = true
 	}
 
-	return isMinValid && isMaxValid, nil
+	return isMinValid && isMaxValid
 }
 
-func IsInHourRange(t time.Time, now time.Time, minD Range, maxD Range) (bool, error) {
-	var diff float32
-	diff = float32(now.Unix()-t.Unix()) / 3600
-
-	isMinValid := false
-	if !minD.IsSkipCheck {
-		if minD.IsEqual {
-			isMinValid = diff >= float32(minD.Value)
-		} else {
-			isMinValid = diff > float32(minD.Value)
-		}
-	} else {
-		isMinValid = true
-	}
-
-	isMaxValid := false
-	if !maxD.IsSkipCheck {
-		if maxD.IsEqual {
-			isMaxValid = diff <= float32(maxD.Value)
-		} else {
-			isMaxValid = diff < float32(maxD.Value)
-		}
-	} else {
-		isMaxValid = true
-	}
+// InDayRange check the number of Asia/Jakarta calendar days from t to now against minD and maxD
+func InDayRange(t time.Time, now time.Time, minD Range, maxD Range) bool {
+	return inRange(float64(DaysBetween(t, now)), minD, maxD)
+}
 
-	return isMinValid && isMaxValid, nil
+// Deprecated: IsInDayRange never fail, use InDayRange
+func IsInDayRange(t time.Time, now time.Time, minD Range, maxD Range) (bool, error) {
+	return InDayRange(t, now, minD, maxD), nil
 }
 
-func IsInMinuteRange(t time.Time, now time.Time, minM Range, maxM Range) (bool, error) {
-	var diff float32
-	diff = float32(now.Unix()-t.Unix()) / 60
+// InHourRange check the fractional number of hours from t to now against minD and maxD
+func InHourRange(t time.Time, now time.Time, minD Range, maxD Range) bool {
+	return inRange(float64(now.Unix()-t.Unix())/3600, minD, maxD)
+}
 
-	isMinValid := false
-	if !minM.IsSkipCheck {
-		if minM.IsEqual {
-			isMinValid = diff >= float32(minM.Value)
-		} else {
-			isMinValid = diff > float32(minM.Value)
-		}
-	} else {
-		isMinValid = true
-	}
+// Deprecated: IsInHourRange never fail, use InHourRange.
+// The hours are still computed in float32 like before, so the result may differ from InHourRange above 2^24 seconds
+func IsInHourRange(t time.Time, now time.Time, minD Range, maxD Range) (bool, error) {
+	return inRange(float32(now.Unix()-t.Unix())/3600, minD, maxD), nil
+}
 
-	isMaxValid := false
-	if !maxM.IsSkipCheck {
-		if maxM.IsEqual {
-			isMaxValid = diff <= float32(maxM.Value)
-		} else {
-			isMaxValid = diff < float32(maxM.Value)
-		}
-	} else {
-		isMaxValid = true
-	}
+// InMinuteRange check the fractional number of minutes from t to now against minM and maxM
+func InMinuteRange(t time.Time, now time.Time, minM Range, maxM Range) bool {
+	return inRange(float64(now.Unix()-t.Unix())/60, minM, maxM)
+}
 
-	return isMinValid && isMaxValid, nil
+// Deprecated: IsInMinuteRange never fail, use InMinuteRange.
+// The minutes are still computed in float32 like before, so the result may differ from InMinuteRange above 2^24 seconds
+func IsInMinuteRange(t time.Time, now time.Time, minM Range, maxM Range) (bool, error) {
+	return inRange(float32(now.Unix()-t.Unix())/60, minM, maxM), nil
 }
 
 type TimeRange struct {
@@ -262,19 +197,11 @@ type TimeRange struct {
 	End   time.Time
 }
 
-func IsInDayRangeStartEnd(r TimeRange, now time.Time, minD Range, maxD Range) (bool, error) {
-	startD, err := GetNthDay(r.Start)
-	if err != nil {
-		return false, err
-	}
-	endD, err := GetNthDay(r.End)
-	if err != nil {
-		return false, err
-	}
-	nowD, err := GetNthDay(now)
-	if err != nil {
-		return false, err
-	}
+// InDayRangeStartEnd check now is between r.Start + minD days and r.End + maxD days, counted in Asia/Jakarta calendar days
+func InDayRangeStartEnd(r TimeRange, now time.Time, minD Range, maxD Range) bool {
+	startD := NthDay(r.Start)
+	endD := NthDay(r.End)
+	nowD := NthDay(now)
 
 	isMinValid := false
 	if !minD.IsSkipCheck {
@@ -300,15 +227,28 @@ func IsInDayRangeStartEnd(r TimeRange, now time.Time, minD Range, maxD Range) (b
 		isMaxValid = true
 	}
 
-	return isMinValid && isMaxValid, nil
+	return isMinValid && isMaxValid
+}
+
+// Deprecated: IsInDayRangeStartEnd never fail, use InDayRangeStartEnd
+func IsInDayRangeStartEnd(r TimeRange, now time.Time, minD Range, maxD Range) (bool, error) {
+	return InDayRangeStartEnd(r, now, minD, maxD), nil
 }
 
-// CombineDateAndHour param: hourStr: "23:00:00"
+// CombineDateAndHour d plus the time of day in hourStr, d is expected to be a midnight, param: hourStr: "23:00:00", validated by DefaultValidation.
+// Use CombineDateAndHourIn to set the wall clock on a calendar date across DST transitions
 func CombineDateAndHour(d time.Time, hourStr string) (time.Time, error) {
-	hourTime, err := time.Parse(time.RFC3339, fmt.Sprintf("1970-01-01T%sZ", hourStr))
-	if err != nil {
-		return time.Time{}, err
-	}
+	return DefaultValidation.CombineDateAndHour(d, hourStr)
+}
+
+// CombineDateAndHourIn wall clock hourStr on the calendar date of d in loc, loc default to Asia/Jakarta.
+// A nonexistent or repeated wall clock is resolved by policy, see Civil
+func CombineDateAndHourIn(d time.Time, hourStr string, loc *time.Location, policy CivilPolicy) (time.Time, error) {
+	return DefaultValidation.CombineDateAndHourIn(d, hourStr, loc, policy)
+}
 
-	return time.Unix(d.Unix()+hourTime.Unix(), 0), nil
+// nthDayIn same as NthDay but use the calendar day of t in loc
+func nthDayIn(t time.Time, loc *time.Location) int {
+	year, month, day := t.In(loc).Date()
+	return int(time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Unix() / 86400)
 }
@@ -2541,3 +2541,22 @@ func TestCombineDateAndHour(t *testing.T) {
 		})
 	}
 }
+
+// TestIsInRange_Float32 the deprecated wrappers keep the float32 rounding, 2^24 hours and one second round to exactly 2^24 hours
+func TestIsInRange_Float32(t *testing.T) {
+	const n = 1 << 24
+	now := time.Unix(0, 0)
+	after := timeutilsgo.Range{Value: n}
+
+	hours := time.Unix(-(n*3600 + 1), 0)
+	actual, err := timeutilsgo.IsInHourRange(hours, now, after, timeutilsgo.Range{IsSkipCheck: true})
+	assert.NoError(t, err)
+	assert.False(t, actual)
+	assert.True(t, timeutilsgo.InHourRange(hours, now, after, timeutilsgo.Range{IsSkipCheck: true}))
+
+	minutes := time.Unix(-(n*60 + 1), 0)
+	actual, err = timeutilsgo.IsInMinuteRange(minutes, now, after, timeutilsgo.Range{IsSkipCheck: true})
+	assert.NoError(t, err)
+	assert.False(t, actual)
+	assert.True(t, timeutilsgo.InMinuteRange(minutes, now, after, timeutilsgo.Range{IsSkipCheck: true}))
+}
@@ -0,0 +1,168 @@
+package timeutils_go_test
+
+import (
+	"math"
+	"testing"
+	"testing/quick"
+	"time"
+
+	timeutilsgo "github.com/harryosmar/timeutils-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDayIndex(t *testing.T) {
+	testData := []struct {
+		name           string
+		t              time.Time
+		expectedDay    int64
+		expectedHour   int64
+		expectedWIBDay int64
+	}{
+		{name: "epoch", t: parseRFC3339("1970-01-01T00:00:00Z"), expectedDay: 0, expectedHour: 0, expectedWIBDay: 0},
+		{name: "last second before epoch", t: parseRFC3339("1969-12-31T23:59:59Z"), expectedDay: -1, expectedHour: -1, expectedWIBDay: 0},
+		{name: "jakarta midnight before epoch", t: parseRFC3339("1969-12-31T17:00:00Z"), expectedDay: -1, expectedHour: -7, expectedWIBDay: 0},
+		{name: "one second before jakarta midnight", t: parseRFC3339("1969-12-31T16:59:59Z"), expectedDay: -1, expectedHour: -8, expectedWIBDay: -1},
+		{name: "birthdate", t: parseRFC3339("1955-12-31T00:00:00+07:00"), expectedDay: -5116, expectedHour: -122767, expectedWIBDay: -5115},
+		{name: "2024", t: parseRFC3339("2024-07-01T08:30:00+07:00"), expectedDay: 19905, expectedHour: 477721, expectedWIBDay: 19905},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedDay, timeutilsgo.DayIndex(tt.t))
+			assert.Equal(t, tt.expectedHour, timeutilsgo.HourIndex(tt.t))
+			assert.Equal(t, tt.expectedWIBDay, timeutilsgo.DayIndexJakartaTimezone(tt.t))
+			assert.Equal(t, tt.expectedHour+7, timeutilsgo.HourIndexJakartaTimezone(tt.t))
+		})
+	}
+}
+
+// baselineIndex the float formula DayInUnix and HourInUnix used before the int64 versions
+func baselineIndex(x int64, size int64) float64 {
+	if x%size == 0 {
+		return float64(x / size)
+	}
+	return math.Ceil(float64(x)/float64(size)) - 1
+}
+
+// baselineNthDay the float formula NthDay used before the int64 versions
+func baselineNthDay(x int64) int {
+	return int(math.Floor(float64(x+7*3600) / 86400))
+}
+
+// TestDayIndex_AgreeWithFloat every second in the positive range exactly representable as float64
+func TestDayIndex_AgreeWithFloat(t *testing.T) {
+	property := func(sec int64) bool {
+		sec = sec & (1<<52 - 1)
+		ts := time.Unix(sec, 0)
+		return float64(timeutilsgo.DayIndex(ts)) == baselineIndex(sec, 86400) &&
+			float64(timeutilsgo.HourIndex(ts)) == baselineIndex(sec, 3600) &&
+			float64(timeutilsgo.DayIndexJakartaTimezone(ts)) == baselineIndex(sec+7*3600, 86400) &&
+			float64(timeutilsgo.HourIndexJakartaTimezone(ts)) == baselineIndex(sec+7*3600, 3600) &&
+			timeutilsgo.DayInUnix(ts) == baselineIndex(sec, 86400) &&
+			timeutilsgo.HourInUnix(ts) == baselineIndex(sec, 3600) &&
+			timeutilsgo.NthDay(ts) == baselineNthDay(sec)
+	}
+	if err := quick.Check(property, &quick.Config{MaxCount: 10000}); err != nil {
+		t.Error(err)
+	}
+
+	for _, sec := range []int64{0, 1, 3599, 3600, 86399, 86400, 86401, 61199, 61200, math.MaxInt32, 1 << 52} {
+		assert.True(t, property(sec), sec)
+	}
+}
+
+func TestZone_DayIndex(t *testing.T) {
+	// 1969-12-31 23:30 WIB is already 1970-01-01 in WITA and WIT
+	ts := parseRFC3339("1969-12-31T23:30:00+07:00")
+	assert.Equal(t, int64(-1), timeutilsgo.WIB.DayIndex(ts))
+	assert.Equal(t, int64(0), timeutilsgo.WITA.DayIndex(ts))
+	assert.Equal(t, int64(1), timeutilsgo.WIT.HourIndex(ts))
+}
+
+func TestZone_GetExpirationTillEndOfToday(t *testing.T) {
+	assert.Equal(t, int64(1), timeutilsgo.WIB.GetExpirationTillEndOfToday(parseRFC3339("1969-12-31T23:59:59+07:00")))
+	assert.Equal(t, int64(86400), timeutilsgo.WIB.GetExpirationTillEndOfToday(parseRFC3339("1955-12-31T00:00:00+07:00")))
+}
+
+func TestDayMath_Extremes(t *testing.T) {
+	testData := []struct {
+		name            string
+		t               time.Time
+		expectedNthDay  int
+		expectedDayUnix float64
+		expectedHour    float64
+	}{
+		{name: "first second", t: parseRFC3339("0001-01-01T00:00:00Z"), expectedNthDay: -719162, expectedDayUnix: -719162, expectedHour: -17259888},
+		{name: "birthdate", t: parseRFC3339("1955-12-31T06:59:59+07:00"), expectedNthDay: -5115, expectedDayUnix: -5116, expectedHour: -122761},
+		{name: "jakarta midnight before epoch", t: parseRFC3339("1969-12-31T17:00:00Z"), expectedNthDay: 0, expectedDayUnix: -1, expectedHour: -7},
+		{name: "last second", t: parseRFC3339("9999-12-31T23:59:59+07:00"), expectedNthDay: 2932896, expectedDayUnix: 2932896, expectedHour: 70389520},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedNthDay, timeutilsgo.NthDay(tt.t))
+			assert.Equal(t, tt.expectedDayUnix, timeutilsgo.DayInUnix(tt.t))
+			assert.Equal(t, tt.expectedHour, timeutilsgo.HourInUnix(tt.t))
+			assert.Equal(t, float64(tt.expectedNthDay), timeutilsgo.DayInUnixJakartaTimezone(tt.t))
+		})
+	}
+
+	gte, lte, err := timeutilsgo.GetMonthRange(12, 9999)
+	assert.NoError(t, err)
+	assert.Equal(t, "9999-12-01T00:00:00+07:00 - 9999-12-31T23:59:59+07:00", formatRange(gte, lte))
+	gte, lte, err = timeutilsgo.GetMonthRange(1, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "0001-01-01T00:00:00+07:00 - 0001-01-31T23:59:59+07:00", formatRange(gte, lte))
+	gte, lte, err = timeutilsgo.GetMonthRange(12, 1969)
+	assert.NoError(t, err)
+	assert.Equal(t, "1969-12-01T00:00:00+07:00 - 1969-12-31T23:59:59+07:00", formatRange(gte, lte))
+
+	birth := parseRFC3339("1955-12-31T23:00:00+07:00")
+	assert.Equal(t, 1, timeutilsgo.DaysBetween(birth, parseRFC3339("1956-01-01T00:00:00+07:00")))
+	assert.True(t, timeutilsgo.InDayRange(birth, parseRFC3339("1956-01-01T00:00:00+07:00"), timeutilsgo.Range{Value: 1, IsEqual: true}, timeutilsgo.Range{IsSkipCheck: true}))
+	assert.True(t, timeutilsgo.InHourRange(birth, parseRFC3339("1956-01-01T00:00:00+07:00"), timeutilsgo.Range{Value: 1, IsEqual: true}, timeutilsgo.Range{Value: 1, IsEqual: true}))
+	assert.True(t, timeutilsgo.InDayRangeStartEnd(timeutilsgo.TimeRange{Start: birth, End: parseRFC3339("9999-12-31T23:59:59+07:00")}, parseRFC3339("1970-01-01T00:00:00Z"), timeutilsgo.Range{}, timeutilsgo.Range{}))
+}
+
+// FuzzDayIndex compare the day and hour index math with references built from time.Date, for years 1 to 9999
+func FuzzDayIndex(f *testing.F) {
+	for _, sec := range []int64{0, -1, 1, -25200, -25201, -86400, -157766400, 1719797400, -62135596800, 253402300799} {
+		f.Add(sec)
+	}
+	const minSec, maxSec = -62135596800, 253402300799 - 9*3600
+	f.Fuzz(func(t *testing.T, sec int64) {
+		sec = minSec + int64(uint64(sec-minSec)%uint64(maxSec-minSec+1))
+		ts := time.Unix(sec, 0)
+
+		utc := ts.UTC()
+		year, month, day := utc.Date()
+		dayRef := time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Unix() / 86400
+		hourRef := time.Date(year, month, day, utc.Hour(), 0, 0, 0, time.UTC).Unix() / 3600
+		if timeutilsgo.DayIndex(ts) != dayRef || timeutilsgo.DayInUnix(ts) != float64(dayRef) {
+			t.Fatalf("%v day index %d, expect %d", utc, timeutilsgo.DayIndex(ts), dayRef)
+		}
+		if timeutilsgo.HourIndex(ts) != hourRef || timeutilsgo.HourInUnix(ts) != float64(hourRef) {
+			t.Fatalf("%v hour index %d, expect %d", utc, timeutilsgo.HourIndex(ts), hourRef)
+		}
+
+		for _, zone := range []timeutilsgo.Zone{timeutilsgo.WIB, timeutilsgo.WITA, timeutilsgo.WIT} {
+			year, month, day := ts.In(zone.Location()).Date()
+			ref := time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Unix() / 86400
+			if zone.DayIndex(ts) != ref || zone.NthDay(ts) != int(ref) {
+				t.Fatalf("%v %s day index %d, expect %d", ts, zone, zone.DayIndex(ts), ref)
+			}
+		}
+		if timeutilsgo.NthDay(ts) != timeutilsgo.WIB.NthDay(ts) {
+			t.Fatalf("%v NthDay %d, expect %d", ts, timeutilsgo.NthDay(ts), timeutilsgo.WIB.NthDay(ts))
+		}
+
+		wib := ts.In(timeutilsgo.WIB.Location())
+		gte, lte, err := timeutilsgo.GetMonthRange(int(wib.Month()), wib.Year())
+		if err != nil || sec < gte || sec > lte {
+			t.Fatalf("%v outside its month range %d..%d %v", wib, gte, lte, err)
+		}
+		if start := timeutilsgo.Floor(ts, timeutilsgo.Day, nil); start.Unix() != int64(timeutilsgo.NthDay(ts))*86400-7*3600 {
+			t.Fatalf("%v floor day %v", wib, start)
+		}
+	})
+}
@@ -0,0 +1,369 @@
+package timeutils_go
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DurationParts a duration split into whole units, a day is always 24 hours and a week 7 days
+type DurationParts struct {
+	Negative    bool
+	Weeks       int64
+	Days        int64
+	Hours       int64
+	Minutes     int64
+	Seconds     int64
+	Nanoseconds int64
+}
+
+var unitSeconds = [Year + 1]int64{
+	Second: 1,
+	Minute: 60,
+	Hour:   3600,
+	Day:    86400,
+	Week:   7 * 86400,
+}
+
+func (p *DurationParts) field(u Unit) *int64 {
+	switch u {
+	case Second:
+		return &p.Seconds
+	case Minute:
+		return &p.Minutes
+	case Hour:
+		return &p.Hours
+	case Day:
+		return &p.Days
+	case Week:
+		return &p.Weeks
+	}
+	return nil
+}
+
+// Duration convert p back to time.Duration, an amount beyond time.Duration saturate to the largest or smallest duration.
+// ParseDuration already reject such amounts
+func (p DurationParts) Duration() time.Duration {
+	d, err := p.checkedDuration()
+	if err == nil {
+		return d
+	}
+	if p.Negative {
+		return math.MinInt64
+	}
+	return math.MaxInt64
+}
+
+var errDurationRange = errors.New("out of range of time.Duration")
+
+func (p DurationParts) checkedDuration() (time.Duration, error) {
+	d := p.Nanoseconds
+	for u := Second; u <= Week; u++ {
+		n, err := mulInt64(*p.field(u), unitSeconds[u]*int64(time.Second))
+		if err != nil {
+			return 0, errDurationRange
+		}
+		if d, err = addInt64(d, n); err != nil {
+			return 0, errDurationRange
+		}
+	}
+	if p.Negative {
+		if d == math.MinInt64 {
+			return 0, errDurationRange
+		}
+		d = -d
+	}
+	return time.Duration(d), nil
+}
+
+func clampDurationUnits(largest Unit, smallest Unit) (Unit, Unit) {
+	if largest == 0 {
+		largest = Day
+	}
+	if smallest == 0 {
+		smallest = Second
+	}
+	largest = min(max(largest, Second), Week)
+	smallest = min(max(smallest, Second), largest)
+	return largest, smallest
+}
+
+// SplitDuration split d into units from largest down to smallest, the remainder below smallest is dropped.
+// largest default to Day and smallest default to Second, units above Week are not exact and are clamped to Week
+func SplitDuration(d time.Duration, largest Unit, smallest Unit) DurationParts {
+	largest, smallest = clampDurationUnits(largest, smallest)
+
+	// the magnitude is split as uint64 since -d overflow for math.MinInt64
+	var p DurationParts
+	magnitude := uint64(d)
+	if d < 0 {
+		p.Negative = true
+		magnitude = -magnitude
+	}
+	sec := magnitude / uint64(time.Second)
+	for u := largest; u >= smallest; u-- {
+		*p.field(u) = int64(sec / uint64(unitSeconds[u]))
+		sec %= uint64(unitSeconds[u])
+	}
+	return p
+}
+
+// DurationStyle DurationShort "2h 15m", DurationLong "2 hours 15 minutes"
+type DurationStyle int
+
+const (
+	DurationShort DurationStyle = iota
+	DurationLong
+)
+
+// DurationFormatParam Largest default to Day, Smallest default to Second
+type DurationFormatParam struct {
+	D        time.Duration
+	Largest  Unit
+	Smallest Unit
+	Locale   Locale
+	Style    DurationStyle
+}
+
+// FormatDuration format p.D skipping zero units, e.g. "2h 15m", "1 hari 3 jam"
+func FormatDuration(p DurationFormatParam) string {
+	largest, smallest := clampDurationUnits(p.Largest, p.Smallest)
+	parts := SplitDuration(p.D, largest, smallest)
+	text := p.Locale.text()
+
+	format := func(u Unit, n int64) string {
+		if p.Style == DurationLong {
+			names := text.durationLong[u]
+			if n == 1 {
+				return fmt.Sprintf("%d %s", n, names[0])
+			}
+			return fmt.Sprintf("%d %s", n, names[1])
+		}
+		return fmt.Sprintf("%d%s", n, text.durationShort[u])
+	}
+
+	var words []string
+	for u := largest; u >= smallest; u-- {
+		if n := *parts.field(u); n != 0 {
+			words = append(words, format(u, n))
+		}
+	}
+	if len(words) == 0 {
+		return format(smallest, 0)
+	}
+
+	s := strings.Join(words, " ")
+	if parts.Negative {
+		return "-" + s
+	}
+	return s
+}
+
+// ParseDuration parse "1d2h", "1w 3d", "2 days 3 hours", "1 hari 3 jam" or an ISO 8601 duration "P1DT2H".
+// years and months are rejected since they are not a fixed amount of time, so are amounts beyond time.Duration
+func ParseDuration(s string) (DurationParts, error) {
+	var p DurationParts
+	in := strings.TrimSpace(s)
+	if in == "" {
+		return p, newParseError(s, durationLayout, ErrInvalidDuration, errors.New("empty input"))
+	}
+
+	switch in[0] {
+	case '-':
+		p.Negative = true
+		in = in[1:]
+	case '+':
+		in = in[1:]
+	}
+
+	if in != "" && (in[0] == 'P' || in[0] == 'p') {
+		c, err := parseISODuration(in)
+		if err != nil {
+			return DurationParts{}, newParseError(s, isoDurationLayout, ErrInvalidDuration, err)
+		}
+		if c.years != 0 || c.months != 0 {
+			return DurationParts{}, newParseError(s, isoDurationLayout, ErrInvalidDuration, errors.New("years and months are not exact durations"))
+		}
+		p.Weeks, p.Days, p.Hours, p.Minutes, p.Seconds, p.Nanoseconds = c.weeks, c.days, c.hours, c.minutes, c.seconds, c.nanos
+		if _, err := p.checkedDuration(); err != nil {
+			return DurationParts{}, newParseError(s, isoDurationLayout, ErrInvalidDuration, err)
+		}
+		return p, nil
+	}
+
+	if err := parseUnitDuration(in, &p); err != nil {
+		return DurationParts{}, newParseError(s, durationLayout, ErrInvalidDuration, err)
+	}
+	if _, err := p.checkedDuration(); err != nil {
+		return DurationParts{}, newParseError(s, durationLayout, ErrInvalidDuration, err)
+	}
+	return p, nil
+}
+
+const (
+	durationLayout    = "duration"
+	isoDurationLayout = "ISO 8601 duration"
+)
+
+var durationUnitWords = map[string]Unit{
+	"s": Second, "sec": Second, "secs": Second, "second": Second, "seconds": Second, "detik": Second, "dtk": Second,
+	"m": Minute, "min": Minute, "mins": Minute, "minute": Minute, "minutes": Minute, "menit": Minute, "mnt": Minute,
+	"h": Hour, "hr": Hour, "hrs": Hour, "hour": Hour, "hours": Hour, "jam": Hour, "j": Hour,
+	"d": Day, "day": Day, "days": Day, "hari": Day, "hri": Day,
+	"w": Week, "wk": Week, "wks": Week, "week": Week, "weeks": Week, "minggu": Week, "mg": Week,
+}
+
+// parseUnitDuration parse a list of number and unit pairs, separated by nothing, spaces, commas, "and" or "dan"
+func parseUnitDuration(s string, p *DurationParts) error {
+	found := false
+	for i := 0; i < len(s); {
+		c := s[i]
+		if c == ' ' || c == ',' {
+			i++
+			continue
+		}
+		if c < '0' || c > '9' {
+			word := readWord(s, i)
+			if word == "and" || word == "dan" {
+				i += len(word)
+				continue
+			}
+			return fmt.Errorf("unexpected %q", word)
+		}
+
+		j := i
+		for j < len(s) && s[j] >= '0' && s[j] <= '9' {
+			j++
+		}
+		n, err := strconv.ParseInt(s[i:j], 10, 64)
+		if err != nil {
+			return err
+		}
+		for j < len(s) && s[j] == ' ' {
+			j++
+		}
+		word := readWord(s, j)
+		u, ok := durationUnitWords[strings.ToLower(word)]
+		if !ok {
+			return fmt.Errorf("unknown unit %q", word)
+		}
+		if *p.field(u), err = addInt64(*p.field(u), n); err != nil {
+			return errDurationRange
+		}
+		found = true
+		i = j + len(word)
+	}
+	if !found {
+		return errors.New("missing amount")
+	}
+	return nil
+}
+
+func readWord(s string, i int) string {
+	j := i
+	for j < len(s) && (s[j] >= 'a' && s[j] <= 'z' || s[j] >= 'A' && s[j] <= 'Z') {
+		j++
+	}
+	if j == i && i < len(s) {
+		return s[i : i+1]
+	}
+	return s[i:j]
+}
+
+// isoComponents the fields of an ISO 8601 duration PnYnMnWnDTnHnMnS
+type isoComponents struct {
+	years   int64
+	months  int64
+	weeks   int64
+	days    int64
+	hours   int64
+	minutes int64
+	seconds int64
+	nanos   int64
+}
+
+// parseISODuration parse an unsigned ISO 8601 duration, only the seconds may have a fraction
+func parseISODuration(s string) (isoComponents, error) {
+	var c isoComponents
+	s = strings.ToUpper(s)
+	if len(s) < 2 || s[0] != 'P' {
+		return c, errors.New("missing P designator")
+	}
+
+	inTime, found, timeFound := false, false, false
+	order := "YMWD"
+	for i := 1; i < len(s); {
+		if s[i] == 'T' {
+			if inTime {
+				return c, errors.New("duplicate T designator")
+			}
+			inTime, order, i = true, "HMS", i+1
+			continue
+		}
+
+		j := i
+		for j < len(s) && (s[j] >= '0' && s[j] <= '9' || s[j] == '.' || s[j] == ',') {
+			j++
+		}
+		if j == i || j == len(s) {
+			return c, fmt.Errorf("expected number and designator at %q", s[i:])
+		}
+		number, designator := strings.ReplaceAll(s[i:j], ",", "."), s[j]
+
+		k := strings.IndexByte(order, designator)
+		if k < 0 {
+			return c, fmt.Errorf("unexpected designator %q", designator)
+		}
+		order = order[k+1:]
+
+		whole, frac, hasFrac := strings.Cut(number, ".")
+		if hasFrac && !(inTime && designator == 'S') {
+			return c, errors.New("fraction is only allowed on seconds")
+		}
+		n, err := strconv.ParseInt(whole, 10, 64)
+		if err != nil {
+			return c, err
+		}
+
+		switch {
+		case !inTime && designator == 'Y':
+			c.years = n
+		case !inTime && designator == 'M':
+			c.months = n
+		case designator == 'W':
+			c.weeks = n
+		case designator == 'D':
+			c.days = n
+		case designator == 'H':
+			c.hours = n
+		case inTime && designator == 'M':
+			c.minutes = n
+		case designator == 'S':
+			c.seconds = n
+			if hasFrac {
+				if len(frac) > 9 {
+					frac = frac[:9]
+				}
+				nanos, err := strconv.ParseInt(frac+strings.Repeat("0", 9-len(frac)), 10, 64)
+				if err != nil {
+					return c, err
+				}
+				c.nanos = nanos
+			}
+		}
+		found = true
+		timeFound = timeFound || inTime
+		i = j + 1
+	}
+
+	if !found {
+		return c, errors.New("missing components")
+	}
+	if inTime && !timeFound {
+		return c, errors.New("missing time components after T")
+	}
+	return c, nil
+}
@@ -0,0 +1,233 @@
+package timeutils_go_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	timeutilsgo "github.com/harryosmar/timeutils-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatDuration(t *testing.T) {
+	testData := []struct {
+		name           string
+		param          timeutilsgo.DurationFormatParam
+		expectedResult string
+	}{
+		{
+			name:           "short",
+			param:          timeutilsgo.DurationFormatParam{D: 2*time.Hour + 15*time.Minute},
+			expectedResult: "2h 15m",
+		},
+		{
+			name: "long indonesian",
+			param: timeutilsgo.DurationFormatParam{
+				D:      27*time.Hour + 20*time.Second,
+				Locale: timeutilsgo.LocaleIndonesian,
+				Style:  timeutilsgo.DurationLong,
+			},
+			expectedResult: "1 hari 3 jam 20 detik",
+		},
+		{
+			name: "long english singular",
+			param: timeutilsgo.DurationFormatParam{
+				D:     time.Hour + time.Minute,
+				Style: timeutilsgo.DurationLong,
+			},
+			expectedResult: "1 hour 1 minute",
+		},
+		{
+			name: "largest hour",
+			param: timeutilsgo.DurationFormatParam{
+				D:       50 * time.Hour,
+				Largest: timeutilsgo.Hour,
+			},
+			expectedResult: "50h",
+		},
+		{
+			name: "weeks down to hours",
+			param: timeutilsgo.DurationFormatParam{
+				D:        10*24*time.Hour + 5*time.Hour + 59*time.Minute,
+				Largest:  timeutilsgo.Week,
+				Smallest: timeutilsgo.Hour,
+			},
+			expectedResult: "1w 3d 5h",
+		},
+		{
+			name:           "zero",
+			param:          timeutilsgo.DurationFormatParam{D: 0, Smallest: timeutilsgo.Minute},
+			expectedResult: "0m",
+		},
+		{
+			name:           "negative",
+			param:          timeutilsgo.DurationFormatParam{D: -90 * time.Second},
+			expectedResult: "-1m 30s",
+		},
+		{
+			name:           "smallest duration",
+			param:          timeutilsgo.DurationFormatParam{D: math.MinInt64},
+			expectedResult: "-106751d 23h 47m 16s",
+		},
+		{
+			name:           "largest duration",
+			param:          timeutilsgo.DurationFormatParam{D: math.MaxInt64},
+			expectedResult: "106751d 23h 47m 16s",
+		},
+		{
+			name: "seconds till end of day",
+			param: timeutilsgo.DurationFormatParam{
+				D:      time.Duration(timeutilsgo.GetExpirationTillEndOfTodayJakartaTimezone(parseRFC3339("1970-01-01T20:00:00+07:00"))) * time.Second,
+				Locale: timeutilsgo.LocaleIndonesian,
+			},
+			expectedResult: "4j",
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := timeutilsgo.FormatDuration(tt.param)
+			if actual != tt.expectedResult {
+				t.Errorf("expect %v got %v", tt.expectedResult, actual)
+			}
+		})
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	testData := []struct {
+		name           string
+		input          string
+		expectedResult timeutilsgo.DurationParts
+		expectedErr    bool
+	}{
+		{
+			name:           "compact",
+			input:          "1d2h",
+			expectedResult: timeutilsgo.DurationParts{Days: 1, Hours: 2},
+		},
+		{
+			name:           "words",
+			input:          "2 days 3 hours",
+			expectedResult: timeutilsgo.DurationParts{Days: 2, Hours: 3},
+		},
+		{
+			name:           "indonesian words",
+			input:          "1 minggu, 1 hari dan 3 jam",
+			expectedResult: timeutilsgo.DurationParts{Weeks: 1, Days: 1, Hours: 3},
+		},
+		{
+			name:           "negative",
+			input:          "-1h30m",
+			expectedResult: timeutilsgo.DurationParts{Negative: true, Hours: 1, Minutes: 30},
+		},
+		{
+			name:           "iso 8601",
+			input:          "P1DT2H",
+			expectedResult: timeutilsgo.DurationParts{Days: 1, Hours: 2},
+		},
+		{
+			name:           "iso 8601 weeks and fraction",
+			input:          "P2WT1.5S",
+			expectedResult: timeutilsgo.DurationParts{Weeks: 2, Seconds: 1, Nanoseconds: 500000000},
+		},
+		{
+			name:        "iso 8601 months",
+			input:       "P1M",
+			expectedErr: true,
+		},
+		{
+			name:        "iso 8601 missing time",
+			input:       "P1DT",
+			expectedErr: true,
+		},
+		{
+			name:        "iso 8601 wrong order",
+			input:       "PT1S2H",
+			expectedErr: true,
+		},
+		{
+			name:        "beyond time.Duration",
+			input:       "20000w",
+			expectedErr: true,
+		},
+		{
+			name:        "iso 8601 beyond time.Duration",
+			input:       "PT2562048H",
+			expectedErr: true,
+		},
+		{
+			name:        "repeated unit overflow",
+			input:       "9223372036854775807s 1s",
+			expectedErr: true,
+		},
+		{
+			name:           "largest duration",
+			input:          "2562047h 47m 16s",
+			expectedResult: timeutilsgo.DurationParts{Hours: 2562047, Minutes: 47, Seconds: 16},
+		},
+		{
+			name:        "unknown unit",
+			input:       "3 fortnights",
+			expectedErr: true,
+		},
+		{
+			name:        "missing unit",
+			input:       "15",
+			expectedErr: true,
+		},
+		{
+			name:        "empty",
+			input:       " ",
+			expectedErr: true,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := timeutilsgo.ParseDuration(tt.input)
+			if tt.expectedErr {
+				assert.ErrorIs(t, err, timeutilsgo.ErrInvalidDuration)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, actual)
+		})
+	}
+}
+
+func TestFormatDuration_ParseRoundTrip(t *testing.T) {
+	d := 2*7*24*time.Hour + 27*time.Hour + 4*time.Minute + 30*time.Second
+	for _, locale := range []timeutilsgo.Locale{timeutilsgo.LocaleEnglish, timeutilsgo.LocaleIndonesian} {
+		for _, style := range []timeutilsgo.DurationStyle{timeutilsgo.DurationShort, timeutilsgo.DurationLong} {
+			for _, in := range []time.Duration{d, -d, 27*time.Hour + 30*time.Second, time.Second, 0} {
+				s := timeutilsgo.FormatDuration(timeutilsgo.DurationFormatParam{D: in, Largest: timeutilsgo.Week, Locale: locale, Style: style})
+				parts, err := timeutilsgo.ParseDuration(s)
+				assert.NoError(t, err, s)
+				assert.Equal(t, in, parts.Duration(), s)
+			}
+		}
+	}
+
+	s := timeutilsgo.FormatDuration(timeutilsgo.DurationFormatParam{D: 27*time.Hour + 30*time.Second, Locale: timeutilsgo.LocaleIndonesian})
+	assert.Equal(t, "1hri 3j 30dtk", s)
+}
+
+func TestDurationParts_Duration(t *testing.T) {
+	d := 8*24*time.Hour + 3*time.Hour + 4*time.Minute + 5*time.Second
+	parts := timeutilsgo.SplitDuration(-d, timeutilsgo.Week, timeutilsgo.Second)
+	assert.Equal(t, timeutilsgo.DurationParts{Negative: true, Weeks: 1, Days: 1, Hours: 3, Minutes: 4, Seconds: 5}, parts)
+	assert.Equal(t, -d, parts.Duration())
+}
+
+func TestSplitDuration_Smallest(t *testing.T) {
+	parts := timeutilsgo.SplitDuration(math.MinInt64, timeutilsgo.Week, timeutilsgo.Second)
+	assert.Equal(t, timeutilsgo.DurationParts{Negative: true, Weeks: 15250, Days: 1, Hours: 23, Minutes: 47, Seconds: 16}, parts)
+	assert.Equal(t, time.Duration(math.MinInt64)/time.Second*time.Second, parts.Duration())
+}
+
+func TestDurationParts_DurationSaturate(t *testing.T) {
+	assert.Equal(t, time.Duration(math.MaxInt64), timeutilsgo.DurationParts{Weeks: 20000}.Duration())
+	assert.Equal(t, time.Duration(math.MinInt64), timeutilsgo.DurationParts{Negative: true, Weeks: 20000}.Duration())
+	assert.Equal(t, 2562047*time.Hour+47*time.Minute+16*time.Second, timeutilsgo.DurationParts{Hours: 2562047, Minutes: 47, Seconds: 16}.Duration())
+}
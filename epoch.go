@@ -0,0 +1,163 @@
+package timeutils_go
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// EpochUnit resolution of an integer count since 1970-01-01 UTC, the zero value is not a unit
+type EpochUnit int
+
+const (
+	EpochSeconds EpochUnit = iota + 1
+	EpochMillis
+	EpochMicros
+	EpochNanos
+)
+
+var epochUnitNames = [...]string{"", "seconds", "milliseconds", "microseconds", "nanoseconds"}
+
+// epochPerSecond number of u in one second
+var epochPerSecond = [...]int64{0, 1, 1e3, 1e6, 1e9}
+
+func (u EpochUnit) String() string {
+	if u < EpochSeconds || u > EpochNanos {
+		return "unknown"
+	}
+	return epochUnitNames[u]
+}
+
+func (u EpochUnit) valid() error {
+	if u < EpochSeconds || u > EpochNanos {
+		return fmt.Errorf("%w: %d", ErrInvalidEpochUnit, int(u))
+	}
+	return nil
+}
+
+// mulInt64 a*b or ErrEpochOverflow
+func mulInt64(a int64, b int64) (int64, error) {
+	if a == 0 || b == 0 {
+		return 0, nil
+	}
+	c := a * b
+	if c/b != a || (a == -1 && b == math.MinInt64) || (b == -1 && a == math.MinInt64) {
+		return 0, fmt.Errorf("%w: %d * %d", ErrEpochOverflow, a, b)
+	}
+	return c, nil
+}
+
+func addInt64(a int64, b int64) (int64, error) {
+	c := a + b
+	if (b > 0 && c < a) || (b < 0 && c > a) {
+		return 0, fmt.Errorf("%w: %d + %d", ErrEpochOverflow, a, b)
+	}
+	return c, nil
+}
+
+// ConvertEpoch v from one unit to another, a coarser unit round toward the past (floor) like DayInUnix,
+// a finer unit that does not fit in int64 return ErrEpochOverflow
+func ConvertEpoch(v int64, from EpochUnit, to EpochUnit) (int64, error) {
+	if err := from.valid(); err != nil {
+		return 0, err
+	}
+	if err := to.valid(); err != nil {
+		return 0, err
+	}
+	if from >= to {
+		return floorDiv64(v, epochPerSecond[from]/epochPerSecond[to]), nil
+	}
+	n, err := mulInt64(v, epochPerSecond[to]/epochPerSecond[from])
+	if err != nil {
+		return 0, fmt.Errorf("converting %d %s to %s: %w", v, from, to, err)
+	}
+	return n, nil
+}
+
+// EpochTime instant of v in unit u, every int64 of every unit is representable
+func EpochTime(v int64, u EpochUnit) (time.Time, error) {
+	if err := u.valid(); err != nil {
+		return time.Time{}, err
+	}
+	per := epochPerSecond[u]
+	sec := floorDiv64(v, per)
+	return time.Unix(sec, (v-sec*per)*(1e9/per)), nil
+}
+
+// ToEpoch t as a count of u, ErrEpochOverflow when it does not fit in int64,
+// e.g. nanoseconds only cover 1677-09-21 to 2262-04-11
+func ToEpoch(t time.Time, u EpochUnit) (int64, error) {
+	if err := u.valid(); err != nil {
+		return 0, err
+	}
+	per := epochPerSecond[u]
+	n, err := mulInt64(t.Unix(), per)
+	if err == nil {
+		n, err = addInt64(n, int64(t.Nanosecond())/(1e9/per))
+	}
+	if err != nil {
+		return 0, fmt.Errorf("%s as epoch %s: %w", t.UTC().Format(time.RFC3339Nano), u, err)
+	}
+	return n, nil
+}
+
+// EpochDays UTC day index of v in unit u, the same as DayInUnix for seconds
+func EpochDays(v int64, u EpochUnit) (int64, error) {
+	sec, err := ConvertEpoch(v, u, EpochSeconds)
+	if err != nil {
+		return 0, err
+	}
+	return floorDiv64(sec, 86400), nil
+}
+
+// EpochHours UTC hour index of v in unit u, the same as HourInUnix for seconds
+func EpochHours(v int64, u EpochUnit) (int64, error) {
+	sec, err := ConvertEpoch(v, u, EpochSeconds)
+	if err != nil {
+		return 0, err
+	}
+	return floorDiv64(sec, 3600), nil
+}
+
+// DaysToEpoch start of UTC day index days as a count of u, ErrEpochOverflow when it does not fit in int64
+func DaysToEpoch(days int64, u EpochUnit) (int64, error) {
+	sec, err := mulInt64(days, 86400)
+	if err != nil {
+		return 0, err
+	}
+	return ConvertEpoch(sec, EpochSeconds, u)
+}
+
+// HoursToEpoch start of UTC hour index hours as a count of u, ErrEpochOverflow when it does not fit in int64
+func HoursToEpoch(hours int64, u EpochUnit) (int64, error) {
+	sec, err := mulInt64(hours, 3600)
+	if err != nil {
+		return 0, err
+	}
+	return ConvertEpoch(sec, EpochSeconds, u)
+}
+
+// DetectEpochUnit guess the unit of a partner feed integer from its magnitude.
+// Below 1e11 is seconds (up to year 5138), below 1e14 milliseconds, below 1e17 microseconds, nanoseconds above,
+// so it is only reliable for instants between 1973-03-03 and 5138; a millisecond before 1973-03-03 read as seconds
+func DetectEpochUnit(v int64) EpochUnit {
+	abs := uint64(v)
+	if v < 0 {
+		abs = uint64(-(v + 1)) + 1
+	}
+	switch {
+	case abs < 1e11:
+		return EpochSeconds
+	case abs < 1e14:
+		return EpochMillis
+	case abs < 1e17:
+		return EpochMicros
+	}
+	return EpochNanos
+}
+
+// ParseEpoch instant of v with its unit guessed by DetectEpochUnit
+func ParseEpoch(v int64) time.Time {
+	t, _ := EpochTime(v, DetectEpochUnit(v))
+	return t
+}
@@ -0,0 +1,129 @@
+package timeutils_go_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	timeutilsgo "github.com/harryosmar/timeutils-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvertEpoch(t *testing.T) {
+	testData := []struct {
+		name           string
+		v              int64
+		from           timeutilsgo.EpochUnit
+		to             timeutilsgo.EpochUnit
+		expectedResult int64
+		expectedErr    error
+	}{
+		{name: "seconds to millis", v: 1719797400, from: timeutilsgo.EpochSeconds, to: timeutilsgo.EpochMillis, expectedResult: 1719797400000},
+		{name: "nanos to seconds", v: 1719797400999999999, from: timeutilsgo.EpochNanos, to: timeutilsgo.EpochSeconds, expectedResult: 1719797400},
+		{name: "negative millis floor", v: -1, from: timeutilsgo.EpochMillis, to: timeutilsgo.EpochSeconds, expectedResult: -1},
+		{name: "micros to millis", v: -1500, from: timeutilsgo.EpochMicros, to: timeutilsgo.EpochMillis, expectedResult: -2},
+		{name: "same unit", v: math.MinInt64, from: timeutilsgo.EpochNanos, to: timeutilsgo.EpochNanos, expectedResult: math.MinInt64},
+		{name: "seconds to nanos overflow", v: 1 << 40, from: timeutilsgo.EpochSeconds, to: timeutilsgo.EpochNanos, expectedErr: timeutilsgo.ErrEpochOverflow},
+		{name: "negative overflow", v: math.MinInt64 / 10, from: timeutilsgo.EpochMillis, to: timeutilsgo.EpochMicros, expectedErr: timeutilsgo.ErrEpochOverflow},
+		{name: "invalid unit", v: 1, to: timeutilsgo.EpochSeconds, expectedErr: timeutilsgo.ErrInvalidEpochUnit},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := timeutilsgo.ConvertEpoch(tt.v, tt.from, tt.to)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, actual)
+		})
+	}
+}
+
+func TestToEpoch(t *testing.T) {
+	ts := time.Date(2024, 7, 1, 1, 30, 0, 123456789, time.UTC)
+	testData := []struct {
+		unit           timeutilsgo.EpochUnit
+		precision      time.Duration
+		expectedResult int64
+	}{
+		{unit: timeutilsgo.EpochSeconds, precision: time.Second, expectedResult: 1719797400},
+		{unit: timeutilsgo.EpochMillis, precision: time.Millisecond, expectedResult: 1719797400123},
+		{unit: timeutilsgo.EpochMicros, precision: time.Microsecond, expectedResult: 1719797400123456},
+		{unit: timeutilsgo.EpochNanos, precision: time.Nanosecond, expectedResult: 1719797400123456789},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.unit.String(), func(t *testing.T) {
+			actual, err := timeutilsgo.ToEpoch(ts, tt.unit)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, actual)
+
+			back, err := timeutilsgo.EpochTime(actual, tt.unit)
+			assert.NoError(t, err)
+			assert.True(t, back.Equal(ts.Truncate(tt.precision)))
+		})
+	}
+
+	before := time.Date(1969, 12, 31, 23, 59, 59, 500000000, time.UTC)
+	actual, err := timeutilsgo.ToEpoch(before, timeutilsgo.EpochMillis)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(-500), actual)
+	back, err := timeutilsgo.EpochTime(-500, timeutilsgo.EpochMillis)
+	assert.NoError(t, err)
+	assert.True(t, back.Equal(before))
+
+	_, err = timeutilsgo.ToEpoch(time.Date(2263, 1, 1, 0, 0, 0, 0, time.UTC), timeutilsgo.EpochNanos)
+	assert.ErrorIs(t, err, timeutilsgo.ErrEpochOverflow)
+	_, err = timeutilsgo.ToEpoch(time.Date(1677, 1, 1, 0, 0, 0, 0, time.UTC), timeutilsgo.EpochNanos)
+	assert.ErrorIs(t, err, timeutilsgo.ErrEpochOverflow)
+}
+
+func TestEpochDays(t *testing.T) {
+	days, err := timeutilsgo.EpochDays(1719797400123, timeutilsgo.EpochMillis)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(timeutilsgo.DayInUnix(time.Unix(1719797400, 0))), days)
+
+	days, err = timeutilsgo.EpochDays(-1, timeutilsgo.EpochNanos)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(-1), days)
+
+	hours, err := timeutilsgo.EpochHours(1719797400123456, timeutilsgo.EpochMicros)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(timeutilsgo.HourInUnix(time.Unix(1719797400, 0))), hours)
+
+	start, err := timeutilsgo.DaysToEpoch(days, timeutilsgo.EpochMillis)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(-86400000), start)
+	start, err = timeutilsgo.HoursToEpoch(hours, timeutilsgo.EpochSeconds)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1719795600), start)
+
+	_, err = timeutilsgo.DaysToEpoch(1<<40, timeutilsgo.EpochNanos)
+	assert.ErrorIs(t, err, timeutilsgo.ErrEpochOverflow)
+}
+
+func TestDetectEpochUnit(t *testing.T) {
+	testData := []struct {
+		v              int64
+		expectedResult timeutilsgo.EpochUnit
+	}{
+		{v: 0, expectedResult: timeutilsgo.EpochSeconds},
+		{v: 1719797400, expectedResult: timeutilsgo.EpochSeconds},
+		{v: -1719797400, expectedResult: timeutilsgo.EpochSeconds},
+		{v: 1719797400123, expectedResult: timeutilsgo.EpochMillis},
+		{v: 1719797400123456, expectedResult: timeutilsgo.EpochMicros},
+		{v: 1719797400123456789, expectedResult: timeutilsgo.EpochNanos},
+		{v: math.MinInt64, expectedResult: timeutilsgo.EpochNanos},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.expectedResult.String(), func(t *testing.T) {
+			assert.Equal(t, tt.expectedResult, timeutilsgo.DetectEpochUnit(tt.v))
+			if tt.v > 1e9 {
+				assert.Equal(t, int64(1719797400), timeutilsgo.ParseEpoch(tt.v).Unix())
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package timeutils_go
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrInvalidYear         = errors.New("invalid year")
+	ErrInvalidMonth        = errors.New("invalid month")
+	ErrInvalidDay          = errors.New("invalid day")
+	ErrInvalidQuarter      = errors.New("invalid quarter")
+	ErrInvalidWeek         = errors.New("invalid week")
+	ErrInvalidRetailPeriod = errors.New("invalid retail period")
+	ErrUnknownLocation     = errors.New("unknown location")
+	ErrInvalidTimeOfDay    = errors.New("invalid time of day")
+	ErrInvalidDuration     = errors.New("invalid duration")
+	ErrInvalidPeriod       = errors.New("invalid period")
+	ErrNonexistentTime     = errors.New("nonexistent local time")
+	ErrAmbiguousTime       = errors.New("ambiguous local time")
+	ErrInvalidDateTime     = errors.New("invalid date time")
+	ErrZeroTime            = errors.New("zero time")
+	ErrInvalidEpochUnit    = errors.New("invalid epoch unit")
+	ErrEpochOverflow       = errors.New("epoch overflow")
+)
+
+// ParseError returned when Input does not match Layout, Err wrap one of the sentinel errors
+type ParseError struct {
+	Input  string
+	Layout string
+	Err    error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("parsing %q as %s: %v", e.Input, e.Layout, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+func newParseError(input string, layout string, sentinel error, cause error) *ParseError {
+	return &ParseError{
+		Input:  input,
+		Layout: layout,
+		Err:    fmt.Errorf("%w: %w", sentinel, cause),
+	}
+}
+
+func unknownLocationError(name string, cause error) error {
+	return fmt.Errorf("%w %q: %w", ErrUnknownLocation, name, cause)
+}
@@ -0,0 +1,68 @@
+package timeutils_go_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	timeutilsgo "github.com/harryosmar/timeutils-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrors(t *testing.T) {
+	_, _, err := timeutilsgo.GetMonthRange(13, 2023)
+	assert.ErrorIs(t, err, timeutilsgo.ErrInvalidMonth)
+
+	_, err = timeutilsgo.Format(timeutilsgo.FormatParam{T: time.Unix(0, 0), Location: "Asia/Nowhere"})
+	assert.ErrorIs(t, err, timeutilsgo.ErrUnknownLocation)
+
+	_, err = timeutilsgo.CombineDateAndHour(time.Unix(0, 0), "25:00:00")
+	assert.ErrorIs(t, err, timeutilsgo.ErrInvalidTimeOfDay)
+	var parseErr *timeutilsgo.ParseError
+	if assert.True(t, errors.As(err, &parseErr)) {
+		assert.Equal(t, "25:00:00", parseErr.Input)
+		assert.Equal(t, "15:04:05", parseErr.Layout)
+	}
+
+	_, err = timeutilsgo.ParseDuration("P1M")
+	assert.ErrorIs(t, err, timeutilsgo.ErrInvalidDuration)
+	if assert.True(t, errors.As(err, &parseErr)) {
+		assert.Equal(t, "P1M", parseErr.Input)
+	}
+
+	_, err = timeutilsgo.ParsePeriod("1 day")
+	assert.ErrorIs(t, err, timeutilsgo.ErrInvalidPeriod)
+}
+
+func TestDeprecatedWrappers(t *testing.T) {
+	t1 := parseRFC3339("2023-03-28T23:00:00+07:00")
+	t2 := parseRFC3339("2023-03-30T01:00:00+07:00")
+	minD := timeutilsgo.Range{Value: 1, IsEqual: true}
+	maxD := timeutilsgo.Range{Value: 2, IsEqual: true}
+
+	nthDay, err := timeutilsgo.GetNthDay(t1)
+	assert.NoError(t, err)
+	assert.Equal(t, timeutilsgo.NthDay(t1), nthDay)
+
+	diff, err := timeutilsgo.DayDiff(t1, t2)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, diff)
+	assert.Equal(t, diff, timeutilsgo.DaysBetween(t1, t2))
+
+	ok, err := timeutilsgo.IsInDayRange(t1, t2, minD, maxD)
+	assert.NoError(t, err)
+	assert.Equal(t, timeutilsgo.InDayRange(t1, t2, minD, maxD), ok)
+
+	ok, err = timeutilsgo.IsInHourRange(t1, t2, minD, maxD)
+	assert.NoError(t, err)
+	assert.Equal(t, timeutilsgo.InHourRange(t1, t2, minD, maxD), ok)
+
+	ok, err = timeutilsgo.IsInMinuteRange(t1, t2, minD, maxD)
+	assert.NoError(t, err)
+	assert.Equal(t, timeutilsgo.InMinuteRange(t1, t2, minD, maxD), ok)
+
+	r := timeutilsgo.TimeRange{Start: t1, End: t1}
+	ok, err = timeutilsgo.IsInDayRangeStartEnd(r, t2, minD, maxD)
+	assert.NoError(t, err)
+	assert.Equal(t, timeutilsgo.InDayRangeStartEnd(r, t2, minD, maxD), ok)
+}
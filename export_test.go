@@ -0,0 +1,26 @@
+package timeutils_go
+
+import (
+	"errors"
+	"time"
+)
+
+// StubMissingTZData make LoadLocation behave as on a system without tzdata until restore is called
+func StubMissingTZData() (restore func()) {
+	original := loadLocation
+	loadLocation = func(name string) (*time.Location, error) {
+		return nil, errors.New("unknown time zone " + name)
+	}
+	clearLocationCache()
+	return func() {
+		loadLocation = original
+		clearLocationCache()
+	}
+}
+
+func clearLocationCache() {
+	locationCache.Range(func(key, _ any) bool {
+		locationCache.Delete(key)
+		return true
+	})
+}
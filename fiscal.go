@@ -0,0 +1,113 @@
+package timeutils_go
+
+import (
+	"fmt"
+	"time"
+)
+
+// FiscalNaming how a fiscal year not starting in January is numbered
+type FiscalNaming int
+
+const (
+	// FiscalNamingEndYear Apr 2025 - Mar 2026 is FY2026
+	FiscalNamingEndYear FiscalNaming = iota
+	// FiscalNamingStartYear Apr 2025 - Mar 2026 is FY2025
+	FiscalNamingStartYear
+	// FiscalNamingSplitYear Apr 2025 - Mar 2026 is FY2025 labelled "FY2025/26"
+	FiscalNamingSplitYear
+)
+
+// FiscalCalendar StartMonth default to January, Location default to Asia/Jakarta
+type FiscalCalendar struct {
+	StartMonth time.Month
+	Naming     FiscalNaming
+	Location   *time.Location
+}
+
+// CalendarQuarters plain calendar year and quarters in Asia/Jakarta
+var CalendarQuarters = FiscalCalendar{StartMonth: time.January}
+
+// monthsRange first second of month in year and last second n months later in loc, the same gte/lte as GetMonthRange
+func monthsRange(year int, month time.Month, n int, loc *time.Location) (gte int64, lte int64) {
+	start := wallTime(year, month, 1, 0, 0, 0, 0, loc)
+	return start.Unix(), start.AddDate(0, n, 0).Unix() - 1
+}
+
+func (c FiscalCalendar) startMonth() time.Month {
+	if c.StartMonth < time.January || c.StartMonth > time.December {
+		return time.January
+	}
+	return c.StartMonth
+}
+
+// startYearOf calendar year in which fiscalYear starts
+func (c FiscalCalendar) startYearOf(fiscalYear int) int {
+	if c.Naming == FiscalNamingEndYear && c.startMonth() != time.January {
+		return fiscalYear - 1
+	}
+	return fiscalYear
+}
+
+// FiscalYearOf fiscal year containing t
+func (c FiscalCalendar) FiscalYearOf(t time.Time) int {
+	fiscalYear, _ := c.QuarterOf(t)
+	return fiscalYear
+}
+
+// QuarterOf fiscal year and quarter (1..4) containing t
+func (c FiscalCalendar) QuarterOf(t time.Time) (fiscalYear int, quarter int) {
+	year, month, _ := t.In(locationOrDefault(c.Location)).Date()
+	start := c.startMonth()
+
+	startYear := year
+	if month < start {
+		startYear--
+	}
+	fiscalYear = startYear
+	if c.Naming == FiscalNamingEndYear && start != time.January {
+		fiscalYear++
+	}
+
+	monthsInto := (int(month) - int(start) + 12) % 12
+	return fiscalYear, monthsInto/3 + 1
+}
+
+// QuarterRange first and last second of the quarter, fiscalYear is validated by DefaultValidation
+func (c FiscalCalendar) QuarterRange(fiscalYear int, quarter int) (gte int64, lte int64, err error) {
+	if err := DefaultValidation.Year(fiscalYear); err != nil {
+		return 0, 0, err
+	}
+	if quarter < 1 || quarter > 4 {
+		return 0, 0, fmt.Errorf("%w: %d is outside 1..4", ErrInvalidQuarter, quarter)
+	}
+
+	gte, lte = monthsRange(c.startYearOf(fiscalYear), c.startMonth()+time.Month((quarter-1)*3), 3, locationOrDefault(c.Location))
+	return gte, lte, nil
+}
+
+// FiscalYearRange first and last second of the fiscal year, fiscalYear is validated by DefaultValidation
+func (c FiscalCalendar) FiscalYearRange(fiscalYear int) (gte int64, lte int64, err error) {
+	if err := DefaultValidation.Year(fiscalYear); err != nil {
+		return 0, 0, err
+	}
+
+	gte, lte = monthsRange(c.startYearOf(fiscalYear), c.startMonth(), 12, locationOrDefault(c.Location))
+	return gte, lte, nil
+}
+
+// YearLabel "FY2025", or "FY2025/26" for FiscalNamingSplitYear
+func (c FiscalCalendar) YearLabel(fiscalYear int) string {
+	if c.Naming == FiscalNamingSplitYear && c.startMonth() != time.January {
+		return fmt.Sprintf("FY%d/%02d", fiscalYear, (fiscalYear+1)%100)
+	}
+	return fmt.Sprintf("FY%d", fiscalYear)
+}
+
+// Label fiscal period label of t, e.g. "FY2025 Q2", empty for the zero time
+func (c FiscalCalendar) Label(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	fiscalYear, quarter := c.QuarterOf(t)
+	return fmt.Sprintf("%s Q%d", c.YearLabel(fiscalYear), quarter)
+}
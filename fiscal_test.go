@@ -0,0 +1,111 @@
+package timeutils_go_test
+
+import (
+	"testing"
+	"time"
+
+	timeutilsgo "github.com/harryosmar/timeutils-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFiscalCalendar_QuarterOf(t *testing.T) {
+	april := timeutilsgo.FiscalCalendar{StartMonth: time.April}
+	testData := []struct {
+		name              string
+		calendar          timeutilsgo.FiscalCalendar
+		t                 time.Time
+		expectedYear      int
+		expectedQuarter   int
+		expectedLabel     string
+		expectedYearRange string
+	}{
+		{
+			name:              "calendar quarters",
+			calendar:          timeutilsgo.CalendarQuarters,
+			t:                 parseRFC3339("2025-05-10T00:00:00+07:00"),
+			expectedYear:      2025,
+			expectedQuarter:   2,
+			expectedLabel:     "FY2025 Q2",
+			expectedYearRange: "2025-01-01T00:00:00+07:00 - 2025-12-31T23:59:59+07:00",
+		},
+		{
+			name:              "april start named by end year",
+			calendar:          april,
+			t:                 parseRFC3339("2025-08-10T00:00:00+07:00"),
+			expectedYear:      2026,
+			expectedQuarter:   2,
+			expectedLabel:     "FY2026 Q2",
+			expectedYearRange: "2025-04-01T00:00:00+07:00 - 2026-03-31T23:59:59+07:00",
+		},
+		{
+			name:              "april start named by start year",
+			calendar:          timeutilsgo.FiscalCalendar{StartMonth: time.April, Naming: timeutilsgo.FiscalNamingStartYear},
+			t:                 parseRFC3339("2026-02-10T00:00:00+07:00"),
+			expectedYear:      2025,
+			expectedQuarter:   4,
+			expectedLabel:     "FY2025 Q4",
+			expectedYearRange: "2025-04-01T00:00:00+07:00 - 2026-03-31T23:59:59+07:00",
+		},
+		{
+			name:              "split year label",
+			calendar:          timeutilsgo.FiscalCalendar{StartMonth: time.April, Naming: timeutilsgo.FiscalNamingSplitYear},
+			t:                 parseRFC3339("2025-04-01T00:00:00+07:00"),
+			expectedYear:      2025,
+			expectedQuarter:   1,
+			expectedLabel:     "FY2025/26 Q1",
+			expectedYearRange: "2025-04-01T00:00:00+07:00 - 2026-03-31T23:59:59+07:00",
+		},
+		{
+			name:              "use jakarta calendar day",
+			calendar:          april,
+			t:                 parseRFC3339("2025-03-31T17:00:00Z"),
+			expectedYear:      2026,
+			expectedQuarter:   1,
+			expectedLabel:     "FY2026 Q1",
+			expectedYearRange: "2025-04-01T00:00:00+07:00 - 2026-03-31T23:59:59+07:00",
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			year, quarter := tt.calendar.QuarterOf(tt.t)
+			assert.Equal(t, tt.expectedYear, year)
+			assert.Equal(t, tt.expectedYear, tt.calendar.FiscalYearOf(tt.t))
+			assert.Equal(t, tt.expectedQuarter, quarter)
+			assert.Equal(t, tt.expectedLabel, tt.calendar.Label(tt.t))
+
+			gte, lte, err := tt.calendar.FiscalYearRange(year)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedYearRange, formatRange(gte, lte))
+
+			gte, lte, err = tt.calendar.QuarterRange(year, quarter)
+			assert.NoError(t, err)
+			assert.LessOrEqual(t, gte, tt.t.Unix())
+			assert.GreaterOrEqual(t, lte, tt.t.Unix())
+		})
+	}
+}
+
+func formatRange(gte int64, lte int64) string {
+	jakarta := time.FixedZone("WIB", 7*3600)
+	return time.Unix(gte, 0).In(jakarta).Format(time.RFC3339) + " - " + time.Unix(lte, 0).In(jakarta).Format(time.RFC3339)
+}
+
+func TestFiscalCalendar_QuarterRange(t *testing.T) {
+	april := timeutilsgo.FiscalCalendar{StartMonth: time.April}
+
+	// a quarter is the first month of GetMonthRange to the last month of GetMonthRange
+	gte, lte, err := april.QuarterRange(2026, 4)
+	assert.NoError(t, err)
+	janGte, _, _ := timeutilsgo.GetMonthRange(1, 2026)
+	_, marLte, _ := timeutilsgo.GetMonthRange(3, 2026)
+	assert.Equal(t, janGte, gte)
+	assert.Equal(t, marLte, lte)
+
+	_, _, err = april.QuarterRange(2026, 5)
+	assert.ErrorIs(t, err, timeutilsgo.ErrInvalidQuarter)
+	_, _, err = april.QuarterRange(0, 1)
+	assert.ErrorIs(t, err, timeutilsgo.ErrInvalidYear)
+	_, _, err = april.FiscalYearRange(10000)
+	assert.ErrorIs(t, err, timeutilsgo.ErrInvalidYear)
+}
@@ -0,0 +1,112 @@
+package timeutils_go
+
+import (
+	"fmt"
+	"time"
+)
+
+// HijriDate a date in the Islamic calendar, Month 1 is Muharram and 9 is Ramadan
+type HijriDate struct {
+	Year  int
+	Month int
+	Day   int
+}
+
+// Format e.g. "1 Ramadan 1446 AH", "1 Ramadan 1446 H"
+func (h HijriDate) Format(locale Locale) string {
+	text := locale.text()
+	month := ""
+	if h.Month >= 1 && h.Month <= 12 {
+		month = text.hijriMonths[h.Month-1]
+	}
+	return fmt.Sprintf("%d %s %d %s", h.Day, month, h.Year, text.hijriEra)
+}
+
+// HijriCalendar tabular (arithmetic) Islamic calendar, days start at midnight in Location.
+// Adjustment is added to the tabular Hijri day to follow a local sighting, e.g. -1 when Ramadan officially starts a day later.
+// Umm al-Qura tables are not included, Location default to Asia/Jakarta
+type HijriCalendar struct {
+	Location   *time.Location
+	Adjustment int
+}
+
+// TabularHijri the tabular Islamic calendar in Asia/Jakarta without adjustment
+var TabularHijri = HijriCalendar{}
+
+const (
+	// hijriEpoch julian day number of 1 Muharram 1 AH, 16 July 622 (Julian)
+	hijriEpoch = 1948440
+	// unixEpochJDN julian day number of 1970-01-01
+	unixEpochJDN = 2440588
+)
+
+// hijriToJDN julian day number of the tabular Hijri date, leap years are 2, 5, 7, 10, 13, 16, 18, 21, 24, 26 and 29 of the 30 year cycle
+func hijriToJDN(year int, month int, day int) int {
+	return day + ceilDiv(59*(month-1), 2) + (year-1)*354 + floorDiv(3+11*year, 30) + hijriEpoch - 1
+}
+
+func jdnToHijri(jdn int) HijriDate {
+	year := floorDiv(30*(jdn-hijriEpoch)+10646, 10631)
+	month := min(12, ceilDiv(2*(jdn-29-hijriToJDN(year, 1, 1)), 59)+1)
+	day := jdn - hijriToJDN(year, month, 1) + 1
+	return HijriDate{Year: year, Month: month, Day: day}
+}
+
+func isHijriLeapYear(year int) bool {
+	return floorMod(14+11*year, 30) < 11
+}
+
+// hijriMonthDays odd months have 30 days, even months 29 and Dhu al-Hijjah 30 in leap years
+func hijriMonthDays(year int, month int) int {
+	if month%2 == 1 || (month == 12 && isHijriLeapYear(year)) {
+		return 30
+	}
+	return 29
+}
+
+// Date Hijri date of the calendar day of t
+func (c HijriCalendar) Date(t time.Time) HijriDate {
+	day := nthDayIn(t, locationOrDefault(c.Location))
+	return jdnToHijri(day + unixEpochJDN + c.Adjustment)
+}
+
+// Time midnight of the Hijri date h, year and month are validated by DefaultValidation
+func (c HijriCalendar) Time(h HijriDate) (time.Time, error) {
+	if err := DefaultValidation.Year(h.Year); err != nil {
+		return time.Time{}, err
+	}
+	if err := DefaultValidation.Month(h.Month); err != nil {
+		return time.Time{}, err
+	}
+	if last := hijriMonthDays(h.Year, h.Month); h.Day < 1 || h.Day > last {
+		return time.Time{}, fmt.Errorf("%w: %d is outside 1..%d for %d-%02d AH", ErrInvalidDay, h.Day, last, h.Year, h.Month)
+	}
+
+	day := hijriToJDN(h.Year, h.Month, h.Day) - unixEpochJDN - c.Adjustment
+	return dayStart(day, locationOrDefault(c.Location)), nil
+}
+
+// MonthRange first and last second of the Hijri month, the same gte/lte as GetMonthRange,
+// e.g. MonthRange(9, 1445) for Ramadan 1445
+func (c HijriCalendar) MonthRange(month int, year int) (gte int64, lte int64, err error) {
+	start, err := c.Time(HijriDate{Year: year, Month: month, Day: 1})
+	if err != nil {
+		return 0, 0, err
+	}
+	gte, lte = c.daysRange(start, hijriMonthDays(year, month))
+	return gte, lte, nil
+}
+
+func (c HijriCalendar) daysRange(start time.Time, days int) (gte int64, lte int64) {
+	loc := locationOrDefault(c.Location)
+	first := nthDayIn(start, loc)
+	return start.Unix(), dayStart(first+days, loc).Unix() - 1
+}
+
+// Format Hijri date of t, e.g. "10 Muharram 1446 AH", empty for the zero time
+func (c HijriCalendar) Format(t time.Time, locale Locale) string {
+	if t.IsZero() {
+		return ""
+	}
+	return c.Date(t).Format(locale)
+}
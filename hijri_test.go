@@ -0,0 +1,72 @@
+package timeutils_go_test
+
+import (
+	"testing"
+	"time"
+
+	timeutilsgo "github.com/harryosmar/timeutils-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHijriCalendar_Date(t *testing.T) {
+	jakarta := time.FixedZone("WIB", 7*3600)
+	testData := []struct {
+		name           string
+		t              time.Time
+		expectedResult timeutilsgo.HijriDate
+	}{
+		{name: "epoch", t: time.Date(622, 7, 19, 0, 0, 0, 0, jakarta), expectedResult: timeutilsgo.HijriDate{Year: 1, Month: 1, Day: 1}},
+		{name: "unix epoch", t: time.Date(1970, 1, 1, 0, 0, 0, 0, jakarta), expectedResult: timeutilsgo.HijriDate{Year: 1389, Month: 10, Day: 22}},
+		{name: "ramadan 1444", t: time.Date(2023, 3, 23, 0, 0, 0, 0, jakarta), expectedResult: timeutilsgo.HijriDate{Year: 1444, Month: 9, Day: 1}},
+		{name: "ramadan 1445", t: time.Date(2024, 3, 11, 0, 0, 0, 0, jakarta), expectedResult: timeutilsgo.HijriDate{Year: 1445, Month: 9, Day: 1}},
+		{name: "idul fitri 1445", t: time.Date(2024, 4, 10, 23, 59, 59, 0, jakarta), expectedResult: timeutilsgo.HijriDate{Year: 1445, Month: 10, Day: 1}},
+		{name: "ramadan 1446", t: time.Date(2025, 3, 1, 0, 0, 0, 0, jakarta), expectedResult: timeutilsgo.HijriDate{Year: 1446, Month: 9, Day: 1}},
+		{name: "use jakarta calendar day", t: time.Date(2025, 2, 28, 17, 0, 0, 0, time.UTC), expectedResult: timeutilsgo.HijriDate{Year: 1446, Month: 9, Day: 1}},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := timeutilsgo.TabularHijri.Date(tt.t)
+			assert.Equal(t, tt.expectedResult, actual)
+
+			back, err := timeutilsgo.TabularHijri.Time(actual)
+			assert.NoError(t, err)
+			assert.Equal(t, timeutilsgo.NthDay(tt.t), timeutilsgo.NthDay(back))
+		})
+	}
+}
+
+func TestHijriCalendar_RoundTrip(t *testing.T) {
+	start := time.Date(1900, 1, 1, 0, 0, 0, 0, time.FixedZone("WIB", 7*3600))
+	for i := 0; i < 365*250; i += 7 {
+		d := start.AddDate(0, 0, i)
+		back, err := timeutilsgo.TabularHijri.Time(timeutilsgo.TabularHijri.Date(d))
+		if err != nil || !back.Equal(d) {
+			t.Fatalf("expect %v got %v %v", d, back, err)
+		}
+	}
+}
+
+func TestHijriCalendar_MonthRange(t *testing.T) {
+	gte, lte, err := timeutilsgo.TabularHijri.MonthRange(9, 1445)
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-03-11T00:00:00+07:00 - 2024-04-09T23:59:59+07:00", formatRange(gte, lte))
+
+	adjusted := timeutilsgo.HijriCalendar{Adjustment: -1}
+	gte, lte, err = adjusted.MonthRange(9, 1445)
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-03-12T00:00:00+07:00 - 2024-04-10T23:59:59+07:00", formatRange(gte, lte))
+
+	_, _, err = timeutilsgo.TabularHijri.MonthRange(13, 1445)
+	assert.ErrorIs(t, err, timeutilsgo.ErrInvalidMonth)
+	_, _, err = timeutilsgo.TabularHijri.MonthRange(1, 0)
+	assert.ErrorIs(t, err, timeutilsgo.ErrInvalidYear)
+	_, err = timeutilsgo.TabularHijri.Time(timeutilsgo.HijriDate{Year: 1445, Month: 2, Day: 30})
+	assert.ErrorIs(t, err, timeutilsgo.ErrInvalidDay)
+}
+
+func TestHijriCalendar_Format(t *testing.T) {
+	ramadan := parseRFC3339("2025-03-01T10:00:00+07:00")
+	assert.Equal(t, "1 Ramadan 1446 AH", timeutilsgo.TabularHijri.Format(ramadan, timeutilsgo.LocaleEnglish))
+	assert.Equal(t, "1 Syawal 1446 H", timeutilsgo.TabularHijri.Format(ramadan.AddDate(0, 0, 30), timeutilsgo.LocaleIndonesian))
+}
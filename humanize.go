@@ -0,0 +1,146 @@
+package timeutils_go
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// Rounding how a fractional amount of unit is turned into a whole number
+type Rounding int
+
+const (
+	RoundHalfUp Rounding = iota
+	RoundFloor
+	RoundCeil
+)
+
+func (r Rounding) apply(x float64) int {
+	switch r {
+	case RoundFloor:
+		return int(math.Floor(x))
+	case RoundCeil:
+		return int(math.Ceil(x))
+	}
+	return int(math.Floor(x + 0.5))
+}
+
+// RelativeThresholds the amount below which a unit is used before moving to the next one,
+// e.g. Minutes 45 means 44 minutes is "44 minutes ago" and 45 minutes is "an hour ago"
+type RelativeThresholds struct {
+	Seconds int
+	Minutes int
+	Hours   int
+	Days    int
+	Months  int
+}
+
+var DefaultRelativeThresholds = RelativeThresholds{
+	Seconds: 45,
+	Minutes: 45,
+	Hours:   22,
+	Days:    26,
+	Months:  11,
+}
+
+// orDefault replace every zero field of th by the one of DefaultRelativeThresholds
+func (th RelativeThresholds) orDefault() RelativeThresholds {
+	if th.Seconds == 0 {
+		th.Seconds = DefaultRelativeThresholds.Seconds
+	}
+	if th.Minutes == 0 {
+		th.Minutes = DefaultRelativeThresholds.Minutes
+	}
+	if th.Hours == 0 {
+		th.Hours = DefaultRelativeThresholds.Hours
+	}
+	if th.Days == 0 {
+		th.Days = DefaultRelativeThresholds.Days
+	}
+	if th.Months == 0 {
+		th.Months = DefaultRelativeThresholds.Months
+	}
+	return th
+}
+
+// RelativeParam each zero field of Thresholds default to DefaultRelativeThresholds, Location default to Asia/Jakarta
+type RelativeParam struct {
+	T          time.Time
+	Now        time.Time
+	Locale     Locale
+	Thresholds RelativeThresholds
+	Rounding   Rounding
+	Location   *time.Location
+}
+
+const (
+	secondsInMonth = 30.436875 * 86400
+	secondsInYear  = 365.2425 * 86400
+)
+
+// RelativeTime phrase the distance between p.T and p.Now, e.g. "3 hours ago", "dalam 2 hari", empty when either is the zero time
+func RelativeTime(p RelativeParam) string {
+	if p.T.IsZero() || p.Now.IsZero() {
+		return ""
+	}
+	th := p.Thresholds.orDefault()
+	text := p.Locale.text()
+
+	diff := p.T.Sub(p.Now).Seconds()
+	phrase := text.future
+	if diff < 0 {
+		diff = -diff
+		phrase = text.past
+	}
+
+	amount := func(unitSeconds float64) int {
+		return max(1, p.Rounding.apply(diff/unitSeconds))
+	}
+
+	var s string
+	if sec := p.Rounding.apply(diff); sec < th.Seconds {
+		s = text.fewSeconds
+	} else if m := amount(60); m < th.Minutes {
+		s = p.Locale.unit(Minute, m)
+	} else if h := amount(3600); h < th.Hours {
+		s = p.Locale.unit(Hour, h)
+	} else if d := amount(86400); d < th.Days {
+		s = p.Locale.unit(Day, d)
+	} else if mo := amount(secondsInMonth); mo < th.Months {
+		s = p.Locale.unit(Month, mo)
+	} else {
+		s = p.Locale.unit(Year, amount(secondsInYear))
+	}
+	return fmt.Sprintf(phrase, s)
+}
+
+// Humanize RelativeTime with default thresholds and rounding
+func Humanize(t time.Time, now time.Time, locale Locale) string {
+	return RelativeTime(RelativeParam{T: t, Now: now, Locale: locale})
+}
+
+// CalendarTime phrase p.T relative to the calendar day of p.Now, e.g. "yesterday at 14:00", "kemarin pukul 14.00",
+// days are counted the same way as DaysBetween but in p.Location, empty when either is the zero time
+func CalendarTime(p RelativeParam) string {
+	if p.T.IsZero() || p.Now.IsZero() {
+		return ""
+	}
+	loc := locationOrDefault(p.Location)
+	text := p.Locale.text()
+	t := p.T.In(loc)
+	clock := t.Format(text.clock)
+
+	switch diff := nthDayIn(p.T, loc) - nthDayIn(p.Now, loc); {
+	case diff == 0:
+		return fmt.Sprintf(text.today, clock)
+	case diff == -1:
+		return fmt.Sprintf(text.yesterday, clock)
+	case diff == 1:
+		return fmt.Sprintf(text.tomorrow, clock)
+	case diff < 0 && diff > -7:
+		return fmt.Sprintf(text.lastWeek, p.Locale.weekday(t.Weekday()), clock)
+	case diff > 0 && diff < 7:
+		return fmt.Sprintf(text.thisWeek, p.Locale.weekday(t.Weekday()), clock)
+	}
+	return t.Format(text.date)
+}
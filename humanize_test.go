@@ -0,0 +1,171 @@
+package timeutils_go_test
+
+import (
+	"testing"
+	"time"
+
+	timeutilsgo "github.com/harryosmar/timeutils-go"
+)
+
+func TestRelativeTime(t *testing.T) {
+	now := parseRFC3339("2023-03-28T12:00:00+07:00")
+	testData := []struct {
+		name           string
+		param          timeutilsgo.RelativeParam
+		expectedResult string
+	}{
+		{
+			name:           "few seconds ago",
+			param:          timeutilsgo.RelativeParam{T: now.Add(-30 * time.Second), Now: now},
+			expectedResult: "a few seconds ago",
+		},
+		{
+			name:           "a minute ago",
+			param:          timeutilsgo.RelativeParam{T: now.Add(-50 * time.Second), Now: now},
+			expectedResult: "a minute ago",
+		},
+		{
+			name:           "5 minutes ago",
+			param:          timeutilsgo.RelativeParam{T: now.Add(-5 * time.Minute), Now: now},
+			expectedResult: "5 minutes ago",
+		},
+		{
+			name:           "3 jam yang lalu",
+			param:          timeutilsgo.RelativeParam{T: now.Add(-3 * time.Hour), Now: now, Locale: timeutilsgo.LocaleIndonesian},
+			expectedResult: "3 jam yang lalu",
+		},
+		{
+			name:           "in 2 days",
+			param:          timeutilsgo.RelativeParam{T: now.Add(50 * time.Hour), Now: now},
+			expectedResult: "in 2 days",
+		},
+		{
+			name:           "dalam sebulan",
+			param:          timeutilsgo.RelativeParam{T: now.Add(27 * 24 * time.Hour), Now: now, Locale: timeutilsgo.LocaleIndonesian},
+			expectedResult: "dalam sebulan",
+		},
+		{
+			name:           "2 years ago",
+			param:          timeutilsgo.RelativeParam{T: now.AddDate(-2, 0, 0), Now: now},
+			expectedResult: "2 years ago",
+		},
+		{
+			name: "floor rounding",
+			param: timeutilsgo.RelativeParam{
+				T: now.Add(-(2*time.Hour + 50*time.Minute)), Now: now, Rounding: timeutilsgo.RoundFloor,
+			},
+			expectedResult: "2 hours ago",
+		},
+		{
+			name: "round half up",
+			param: timeutilsgo.RelativeParam{
+				T: now.Add(-(2*time.Hour + 50*time.Minute)), Now: now,
+			},
+			expectedResult: "3 hours ago",
+		},
+		{
+			name: "custom thresholds",
+			param: timeutilsgo.RelativeParam{
+				T: now.Add(-90 * time.Minute), Now: now,
+				Thresholds: timeutilsgo.RelativeThresholds{Seconds: 60, Minutes: 120, Hours: 24, Days: 30, Months: 12},
+			},
+			expectedResult: "90 minutes ago",
+		},
+		{
+			name: "partial thresholds keep the default seconds",
+			param: timeutilsgo.RelativeParam{
+				T: now.Add(-30 * time.Second), Now: now,
+				Thresholds: timeutilsgo.RelativeThresholds{Minutes: 120},
+			},
+			expectedResult: "a few seconds ago",
+		},
+		{
+			name: "partial thresholds keep the default hours",
+			param: timeutilsgo.RelativeParam{
+				T: now.Add(3 * time.Hour), Now: now,
+				Thresholds: timeutilsgo.RelativeThresholds{Days: 7},
+			},
+			expectedResult: "in 3 hours",
+		},
+		{
+			name: "partial thresholds",
+			param: timeutilsgo.RelativeParam{
+				T: now.Add(-10 * 24 * time.Hour), Now: now,
+				Thresholds: timeutilsgo.RelativeThresholds{Days: 14},
+			},
+			expectedResult: "10 days ago",
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := timeutilsgo.RelativeTime(tt.param)
+			if actual != tt.expectedResult {
+				t.Errorf("expect %v got %v", tt.expectedResult, actual)
+			}
+		})
+	}
+}
+
+func TestCalendarTime(t *testing.T) {
+	// Tuesday
+	now := parseRFC3339("2023-03-28T00:30:00+07:00")
+	testData := []struct {
+		name           string
+		param          timeutilsgo.RelativeParam
+		expectedResult string
+	}{
+		{
+			name:           "today",
+			param:          timeutilsgo.RelativeParam{T: parseRFC3339("2023-03-28T14:00:00+07:00"), Now: now},
+			expectedResult: "today at 14:00",
+		},
+		{
+			name:           "yesterday follow jakarta day boundary",
+			param:          timeutilsgo.RelativeParam{T: parseRFC3339("2023-03-27T23:59:00+07:00"), Now: now},
+			expectedResult: "yesterday at 23:59",
+		},
+		{
+			name:           "kemarin",
+			param:          timeutilsgo.RelativeParam{T: parseRFC3339("2023-03-27T14:00:00+07:00"), Now: now, Locale: timeutilsgo.LocaleIndonesian},
+			expectedResult: "kemarin pukul 14.00",
+		},
+		{
+			name:           "besok",
+			param:          timeutilsgo.RelativeParam{T: parseRFC3339("2023-03-29T08:15:00+07:00"), Now: now, Locale: timeutilsgo.LocaleIndonesian},
+			expectedResult: "besok pukul 08.15",
+		},
+		{
+			name:           "last week",
+			param:          timeutilsgo.RelativeParam{T: parseRFC3339("2023-03-24T09:00:00+07:00"), Now: now},
+			expectedResult: "last Friday at 09:00",
+		},
+		{
+			name:           "next days",
+			param:          timeutilsgo.RelativeParam{T: parseRFC3339("2023-04-01T09:00:00+07:00"), Now: now, Locale: timeutilsgo.LocaleIndonesian},
+			expectedResult: "Sabtu pukul 09.00",
+		},
+		{
+			name:           "far away",
+			param:          timeutilsgo.RelativeParam{T: parseRFC3339("2023-04-20T09:00:00+07:00"), Now: now, Locale: timeutilsgo.LocaleIndonesian},
+			expectedResult: "20/04/2023",
+		},
+		{
+			name: "other location",
+			param: timeutilsgo.RelativeParam{
+				T: parseRFC3339("2023-03-27T23:59:00+07:00"), Now: now,
+				Location: time.FixedZone("WITA", 8*3600),
+			},
+			expectedResult: "today at 00:59",
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := timeutilsgo.CalendarTime(tt.param)
+			if actual != tt.expectedResult {
+				t.Errorf("expect %v got %v", tt.expectedResult, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+package timeutils_go
+
+import (
+	"strings"
+	"time"
+)
+
+// Zone one of the Indonesian time zones, none of them observe DST
+type Zone struct {
+	// Name abbreviation, e.g. "WIB"
+	Name string
+	// IANA name used by Format, e.g. "Asia/Jakarta"
+	IANA string
+	// Offset seconds east of UTC
+	Offset int
+	loc    *time.Location
+}
+
+var (
+	// WIB Waktu Indonesia Barat, UTC+7, Sumatra, Java and west and central Kalimantan
+	WIB = Zone{Name: "WIB", IANA: "Asia/Jakarta", Offset: 7 * 3600, loc: jakartaLocation}
+	// WITA Waktu Indonesia Tengah, UTC+8, Bali, Nusa Tenggara, south, east and north Kalimantan and Sulawesi
+	WITA = Zone{Name: "WITA", IANA: "Asia/Makassar", Offset: 8 * 3600, loc: witaLocation}
+	// WIT Waktu Indonesia Timur, UTC+9, Maluku and Papua
+	WIT = Zone{Name: "WIT", IANA: "Asia/Jayapura", Offset: 9 * 3600, loc: witLocation}
+)
+
+// Location fixed offset location of the zone
+func (z Zone) Location() *time.Location {
+	if z.loc == nil {
+		return time.FixedZone(z.Name, z.Offset)
+	}
+	return z.loc
+}
+
+// IANALocation the IANA location of the zone, as used by Format, with the offsets before 1964,
+// or the fixed offset location when tzdata is missing
+func (z Zone) IANALocation() *time.Location {
+	loc, err := LoadLocation(z.IANA)
+	if err != nil {
+		return z.Location()
+	}
+	return loc
+}
+
+func (z Zone) String() string {
+	return z.Name
+}
+
+// DayInUnix number of calendar days since 1970-01-01 in the zone
+func (z Zone) DayInUnix(t time.Time) float64 {
+	return DayInUnix(time.Unix(t.Unix()+int64(z.Offset), 0))
+}
+
+// HourInUnix number of hours since 1970-01-01 00:00 in the zone
+func (z Zone) HourInUnix(t time.Time) float64 {
+	return HourInUnix(time.Unix(t.Unix()+int64(z.Offset), 0))
+}
+
+// DayIndex number of calendar days since 1970-01-01 in the zone, the int64 version of DayInUnix
+func (z Zone) DayIndex(t time.Time) int64 {
+	return floorDiv64(t.Unix()+int64(z.Offset), 86400)
+}
+
+// HourIndex number of hours since 1970-01-01 00:00 in the zone, the int64 version of HourInUnix
+func (z Zone) HourIndex(t time.Time) int64 {
+	return floorDiv64(t.Unix()+int64(z.Offset), 3600)
+}
+
+// GetExpirationTillEndOfToday seconds from t to the end of its calendar day in the zone
+func (z Zone) GetExpirationTillEndOfToday(t time.Time) int64 {
+	return (z.DayIndex(t)+1)*86400 - t.Unix() - int64(z.Offset)
+}
+
+// NthDay same as NthDay for the calendar day in the zone
+func (z Zone) NthDay(t time.Time) int {
+	return nthDayIn(t, z.Location())
+}
+
+// Format t with layout in the zone IANA location
+func (z Zone) Format(t time.Time, layout string) (string, error) {
+	return Format(FormatParam{
+		T:        t,
+		Location: z.IANA,
+		Format:   layout,
+	})
+}
+
+// FormatMySQLDate t as "2006-01-02 15:04:05" in the zone
+func (z Zone) FormatMySQLDate(t time.Time) (string, error) {
+	return z.Format(t, "2006-01-02 15:04:05")
+}
+
+// SameDay a and b fall on the same calendar day in the zone
+func (z Zone) SameDay(a time.Time, b time.Time) bool {
+	return z.NthDay(a) == z.NthDay(b)
+}
+
+// SameBusinessDay a in zone za and b in zone zb fall on the same Monday to Friday calendar date,
+// e.g. a pickup at 23:30 WIB and a delivery at 00:30 WITA of the next date are not on the same business day
+func SameBusinessDay(a time.Time, za Zone, b time.Time, zb Zone) bool {
+	day := za.NthDay(a)
+	if day != zb.NthDay(b) {
+		return false
+	}
+	weekday := dayStart(day, time.UTC).Weekday()
+	return weekday != time.Saturday && weekday != time.Sunday
+}
+
+var provinceZones = map[string]Zone{
+	"aceh":                      WIB,
+	"sumatera utara":            WIB,
+	"sumatera barat":            WIB,
+	"riau":                      WIB,
+	"kepulauan riau":            WIB,
+	"jambi":                     WIB,
+	"sumatera selatan":          WIB,
+	"kepulauan bangka belitung": WIB,
+	"bengkulu":                  WIB,
+	"lampung":                   WIB,
+	"dki jakarta":               WIB,
+	"jawa barat":                WIB,
+	"banten":                    WIB,
+	"jawa tengah":               WIB,
+	"di yogyakarta":             WIB,
+	"jawa timur":                WIB,
+	"kalimantan barat":          WIB,
+	"kalimantan tengah":         WIB,
+	"bali":                      WITA,
+	"nusa tenggara barat":       WITA,
+	"nusa tenggara timur":       WITA,
+	"kalimantan selatan":        WITA,
+	"kalimantan timur":          WITA,
+	"kalimantan utara":          WITA,
+	"sulawesi utara":            WITA,
+	"gorontalo":                 WITA,
+	"sulawesi tengah":           WITA,
+	"sulawesi barat":            WITA,
+	"sulawesi selatan":          WITA,
+	"sulawesi tenggara":         WITA,
+	"maluku":                    WIT,
+	"maluku utara":              WIT,
+	"papua":                     WIT,
+	"papua barat":               WIT,
+	"papua barat daya":          WIT,
+	"papua tengah":              WIT,
+	"papua pegunungan":          WIT,
+	"papua selatan":             WIT,
+}
+
+// ProvinceZone zone of one of the 38 provinces by its Indonesian name, e.g. "Sulawesi Selatan",
+// case and extra spaces are ignored and "Jakarta" and "Yogyakarta" are accepted without the DKI/DI prefix
+func ProvinceZone(province string) (Zone, bool) {
+	name := strings.ToLower(strings.Join(strings.Fields(province), " "))
+	if zone, ok := provinceZones[name]; ok {
+		return zone, true
+	}
+	zone, ok := provinceZones["dki "+name]
+	if !ok {
+		zone, ok = provinceZones["di "+name]
+	}
+	return zone, ok
+}
@@ -0,0 +1,92 @@
+package timeutils_go_test
+
+import (
+	"testing"
+
+	timeutilsgo "github.com/harryosmar/timeutils-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZone(t *testing.T) {
+	// 2024-07-01 23:30:00 WIB
+	ts := parseRFC3339("2024-07-01T16:30:00Z")
+	testData := []struct {
+		zone               timeutilsgo.Zone
+		expectedDay        float64
+		expectedHour       float64
+		expectedExpiration int64
+		expectedMySQL      string
+	}{
+		{zone: timeutilsgo.WIB, expectedDay: 19905, expectedHour: 477743, expectedExpiration: 1800, expectedMySQL: "2024-07-01 23:30:00"},
+		{zone: timeutilsgo.WITA, expectedDay: 19906, expectedHour: 477744, expectedExpiration: 84600, expectedMySQL: "2024-07-02 00:30:00"},
+		{zone: timeutilsgo.WIT, expectedDay: 19906, expectedHour: 477745, expectedExpiration: 81000, expectedMySQL: "2024-07-02 01:30:00"},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.zone.String(), func(t *testing.T) {
+			assert.Equal(t, tt.expectedDay, tt.zone.DayInUnix(ts))
+			assert.Equal(t, tt.expectedHour, tt.zone.HourInUnix(ts))
+			assert.Equal(t, tt.expectedExpiration, tt.zone.GetExpirationTillEndOfToday(ts))
+			assert.Equal(t, int(tt.expectedDay), tt.zone.NthDay(ts))
+
+			actual, err := tt.zone.FormatMySQLDate(ts)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedMySQL, actual)
+			assert.Equal(t, tt.zone.Name, ts.In(tt.zone.Location()).Format("MST"))
+		})
+	}
+
+	assert.Equal(t, timeutilsgo.DayInUnixJakartaTimezone(ts), timeutilsgo.WIB.DayInUnix(ts))
+	assert.Equal(t, timeutilsgo.GetExpirationTillEndOfTodayJakartaTimezone(ts), timeutilsgo.WIB.GetExpirationTillEndOfToday(ts))
+}
+
+func TestSameBusinessDay(t *testing.T) {
+	testData := []struct {
+		name           string
+		a              string
+		za             timeutilsgo.Zone
+		b              string
+		zb             timeutilsgo.Zone
+		expectedResult bool
+	}{
+		{name: "same date across zones", a: "2024-07-01T08:00:00+07:00", za: timeutilsgo.WIB, b: "2024-07-01T17:00:00+09:00", zb: timeutilsgo.WIT, expectedResult: true},
+		{name: "next date in WITA", a: "2024-07-01T23:30:00+07:00", za: timeutilsgo.WIB, b: "2024-07-02T00:30:00+08:00", zb: timeutilsgo.WITA, expectedResult: false},
+		{name: "same instant read in both zones", a: "2024-07-01T23:30:00+07:00", za: timeutilsgo.WIB, b: "2024-07-01T23:30:00+07:00", zb: timeutilsgo.WITA, expectedResult: false},
+		{name: "saturday", a: "2024-07-06T08:00:00+07:00", za: timeutilsgo.WIB, b: "2024-07-06T10:00:00+08:00", zb: timeutilsgo.WITA, expectedResult: false},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := timeutilsgo.SameBusinessDay(parseRFC3339(tt.a), tt.za, parseRFC3339(tt.b), tt.zb)
+			assert.Equal(t, tt.expectedResult, actual)
+		})
+	}
+
+	assert.True(t, timeutilsgo.WITA.SameDay(parseRFC3339("2024-07-01T16:00:00Z"), parseRFC3339("2024-07-02T15:59:59Z")))
+}
+
+func TestProvinceZone(t *testing.T) {
+	testData := []struct {
+		province       string
+		expectedResult timeutilsgo.Zone
+		expectedOk     bool
+	}{
+		{province: "DKI Jakarta", expectedResult: timeutilsgo.WIB, expectedOk: true},
+		{province: "jakarta", expectedResult: timeutilsgo.WIB, expectedOk: true},
+		{province: "Yogyakarta", expectedResult: timeutilsgo.WIB, expectedOk: true},
+		{province: "Kalimantan Barat", expectedResult: timeutilsgo.WIB, expectedOk: true},
+		{province: "  sulawesi   SELATAN ", expectedResult: timeutilsgo.WITA, expectedOk: true},
+		{province: "Bali", expectedResult: timeutilsgo.WITA, expectedOk: true},
+		{province: "Papua Pegunungan", expectedResult: timeutilsgo.WIT, expectedOk: true},
+		{province: "Maluku Utara", expectedResult: timeutilsgo.WIT, expectedOk: true},
+		{province: "Selangor"},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.province, func(t *testing.T) {
+			actual, ok := timeutilsgo.ProvinceZone(tt.province)
+			assert.Equal(t, tt.expectedOk, ok)
+			assert.Equal(t, tt.expectedResult, actual)
+		})
+	}
+}
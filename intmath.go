@@ -0,0 +1,28 @@
+package timeutils_go
+
+// floorDiv a / b rounded toward negative infinity
+func floorDiv(a int, b int) int {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+	return q
+}
+
+// floorMod the remainder of floorDiv, with the sign of b
+func floorMod(a int, b int) int {
+	return a - floorDiv(a, b)*b
+}
+
+func ceilDiv(a int, b int) int {
+	return -floorDiv(-a, b)
+}
+
+// floorDiv64 floorDiv for unix seconds and epoch values
+func floorDiv64(a int64, b int64) int64 {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+	return q
+}
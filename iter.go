@@ -0,0 +1,73 @@
+package timeutils_go
+
+import (
+	"iter"
+	"time"
+)
+
+// IterParam Location default to Asia/Jakarta, Step default to 1
+type IterParam struct {
+	Start    time.Time
+	End      time.Time
+	Location *time.Location
+	Step     int
+	Reverse  bool
+}
+
+// Iterate yield the start of every unit between p.Start and p.End inclusive,
+// the first value is p.Start floored to the unit in p.Location
+func Iterate(u Unit, p IterParam) iter.Seq[time.Time] {
+	return func(yield func(time.Time) bool) {
+		for _, r := range Ranges(u, p) {
+			if !yield(r.Start) {
+				return
+			}
+		}
+	}
+}
+
+// Ranges same as Iterate but yield the index and the whole unit range,
+// End is the last second of the unit like the lte of GetMonthRange
+func Ranges(u Unit, p IterParam) iter.Seq2[int, TimeRange] {
+	return func(yield func(int, TimeRange) bool) {
+		loc := locationOrDefault(p.Location)
+		step := p.Step
+		if step < 1 {
+			step = 1
+		}
+		first := floorUnit(p.Start, u, loc)
+		last := floorUnit(p.End, u, loc)
+
+		origin, dir := first, 1
+		if p.Reverse {
+			origin, dir = last, -1
+		}
+		for i := 0; ; i++ {
+			start := addUnits(origin, u, dir*i*step, loc)
+			if start.Before(first) || start.After(last) {
+				return
+			}
+			end := addUnits(start, u, 1, loc).Add(-time.Second)
+			if !yield(i, TimeRange{Start: start, End: end}) {
+				return
+			}
+		}
+	}
+}
+
+func Days(start time.Time, end time.Time, loc *time.Location) iter.Seq[time.Time] {
+	return Iterate(Day, IterParam{Start: start, End: end, Location: loc})
+}
+
+func Hours(start time.Time, end time.Time, loc *time.Location) iter.Seq[time.Time] {
+	return Iterate(Hour, IterParam{Start: start, End: end, Location: loc})
+}
+
+// Weeks yield the start of every week, weeks start on Monday
+func Weeks(start time.Time, end time.Time, loc *time.Location) iter.Seq[time.Time] {
+	return Iterate(Week, IterParam{Start: start, End: end, Location: loc})
+}
+
+func Months(start time.Time, end time.Time, loc *time.Location) iter.Seq[time.Time] {
+	return Iterate(Month, IterParam{Start: start, End: end, Location: loc})
+}
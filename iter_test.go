@@ -0,0 +1,173 @@
+package timeutils_go_test
+
+import (
+	"testing"
+	"time"
+
+	timeutilsgo "github.com/harryosmar/timeutils-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func parseRFC3339(s string) time.Time {
+	parse, _ := time.Parse(time.RFC3339, s)
+	return parse
+}
+
+func formatAll(ts []time.Time) []string {
+	result := make([]string, 0, len(ts))
+	for _, t := range ts {
+		result = append(result, t.Format(time.RFC3339))
+	}
+	return result
+}
+
+func TestIterate(t *testing.T) {
+	type args struct {
+		unit  timeutilsgo.Unit
+		param timeutilsgo.IterParam
+	}
+	testData := []struct {
+		name           string
+		args           args
+		expectedResult []string
+	}{
+		{
+			name: "days floor the start",
+			args: args{
+				unit: timeutilsgo.Day,
+				param: timeutilsgo.IterParam{
+					Start: parseRFC3339("2023-03-28T10:00:00+07:00"),
+					End:   parseRFC3339("2023-03-30T00:00:00+07:00"),
+				},
+			},
+			expectedResult: []string{
+				"2023-03-28T00:00:00+07:00",
+				"2023-03-29T00:00:00+07:00",
+				"2023-03-30T00:00:00+07:00",
+			},
+		},
+		{
+			name: "days with step and reverse",
+			args: args{
+				unit: timeutilsgo.Day,
+				param: timeutilsgo.IterParam{
+					Start:   parseRFC3339("2023-03-28T10:00:00+07:00"),
+					End:     parseRFC3339("2023-04-02T23:00:00+07:00"),
+					Step:    2,
+					Reverse: true,
+				},
+			},
+			expectedResult: []string{
+				"2023-04-02T00:00:00+07:00",
+				"2023-03-31T00:00:00+07:00",
+				"2023-03-29T00:00:00+07:00",
+			},
+		},
+		{
+			name: "hours",
+			args: args{
+				unit: timeutilsgo.Hour,
+				param: timeutilsgo.IterParam{
+					Start: parseRFC3339("2023-03-28T22:30:00+07:00"),
+					End:   parseRFC3339("2023-03-29T00:10:00+07:00"),
+				},
+			},
+			expectedResult: []string{
+				"2023-03-28T22:00:00+07:00",
+				"2023-03-28T23:00:00+07:00",
+				"2023-03-29T00:00:00+07:00",
+			},
+		},
+		{
+			name: "weeks start on monday",
+			args: args{
+				unit: timeutilsgo.Week,
+				param: timeutilsgo.IterParam{
+					Start: parseRFC3339("2023-03-29T00:00:00+07:00"),
+					End:   parseRFC3339("2023-04-10T00:00:00+07:00"),
+				},
+			},
+			expectedResult: []string{
+				"2023-03-27T00:00:00+07:00",
+				"2023-04-03T00:00:00+07:00",
+				"2023-04-10T00:00:00+07:00",
+			},
+		},
+		{
+			name: "months across year end",
+			args: args{
+				unit: timeutilsgo.Month,
+				param: timeutilsgo.IterParam{
+					Start: parseRFC3339("2022-11-30T00:00:00+07:00"),
+					End:   parseRFC3339("2023-01-31T00:00:00+07:00"),
+				},
+			},
+			expectedResult: []string{
+				"2022-11-01T00:00:00+07:00",
+				"2022-12-01T00:00:00+07:00",
+				"2023-01-01T00:00:00+07:00",
+			},
+		},
+		{
+			name: "end before start",
+			args: args{
+				unit: timeutilsgo.Day,
+				param: timeutilsgo.IterParam{
+					Start: parseRFC3339("2023-03-30T00:00:00+07:00"),
+					End:   parseRFC3339("2023-03-28T00:00:00+07:00"),
+				},
+			},
+			expectedResult: []string{},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			var actual []time.Time
+			for d := range timeutilsgo.Iterate(tt.args.unit, tt.args.param) {
+				actual = append(actual, d)
+			}
+			assert.Equal(t, tt.expectedResult, formatAll(actual))
+		})
+	}
+}
+
+func TestRanges(t *testing.T) {
+	start := parseRFC3339("2023-01-15T00:00:00+07:00")
+	end := parseRFC3339("2023-03-01T00:00:00+07:00")
+
+	var actual []string
+	for i, r := range timeutilsgo.Ranges(timeutilsgo.Month, timeutilsgo.IterParam{Start: start, End: end}) {
+		gte, lte, err := timeutilsgo.GetMonthRange(i+1, 2023)
+		assert.NoError(t, err)
+		assert.Equal(t, gte, r.Start.Unix())
+		assert.Equal(t, lte, r.End.Unix())
+		actual = append(actual, r.End.Format(time.RFC3339))
+	}
+	assert.Equal(t, []string{
+		"2023-01-31T23:59:59+07:00",
+		"2023-02-28T23:59:59+07:00",
+		"2023-03-31T23:59:59+07:00",
+	}, actual)
+}
+
+func TestDays(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+	start := time.Date(2024, 3, 9, 12, 0, 0, 0, loc)
+	end := time.Date(2024, 3, 11, 12, 0, 0, 0, loc)
+
+	var actual []time.Time
+	for d := range timeutilsgo.Days(start, end, loc) {
+		actual = append(actual, d)
+		if len(actual) == 2 {
+			break
+		}
+	}
+	assert.Equal(t, []string{
+		"2024-03-09T00:00:00-05:00",
+		"2024-03-10T00:00:00-05:00",
+	}, formatAll(actual))
+}
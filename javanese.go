@@ -0,0 +1,128 @@
+package timeutils_go
+
+import (
+	"fmt"
+	"time"
+)
+
+// Pasaran the five day Javanese market week
+type Pasaran int
+
+const (
+	Legi Pasaran = iota
+	Pahing
+	Pon
+	Wage
+	Kliwon
+)
+
+var pasaranNames = [5]string{"Legi", "Pahing", "Pon", "Wage", "Kliwon"}
+
+func (p Pasaran) String() string {
+	return pasaranNames[floorMod(int(p), 5)]
+}
+
+// Neptu value of the pasaran used to compute the neptu of a weton
+func (p Pasaran) Neptu() int {
+	return [5]int{5, 9, 7, 4, 8}[floorMod(int(p), 5)]
+}
+
+const (
+	// legiDay day index of a Legi day, 1945-08-17 was Jumat Legi
+	legiDay = -8903
+	// mingguLegiDay day index of a Minggu Legi, the first day of the weton cycle
+	mingguLegiDay = -8873
+	// sintaDay day index of the first day of wuku Sinta, Sunday 2023-12-17
+	sintaDay = 19708
+)
+
+// PasaranOf pasaran of the calendar day of t in loc, loc default to Asia/Jakarta
+func PasaranOf(t time.Time, loc *time.Location) Pasaran {
+	return Pasaran(floorMod(nthDayIn(t, locationOrDefault(loc))-legiDay, 5))
+}
+
+// Weton weekday and pasaran of a day, repeating every 35 days
+type Weton struct {
+	Weekday time.Weekday
+	Pasaran Pasaran
+}
+
+var wetonWeekdayNames = [7]string{"Minggu", "Senin", "Selasa", "Rabu", "Kamis", "Jumat", "Sabtu"}
+
+// String e.g. "Jumat Legi"
+func (w Weton) String() string {
+	return wetonWeekdayNames[w.Weekday] + " " + w.Pasaran.String()
+}
+
+// Neptu sum of the weekday and pasaran neptu, from 7 to 18
+func (w Weton) Neptu() int {
+	return [7]int{5, 4, 3, 7, 8, 6, 9}[w.Weekday] + w.Pasaran.Neptu()
+}
+
+// WetonOf weton of the calendar day of t in loc
+func WetonOf(t time.Time, loc *time.Location) Weton {
+	loc = locationOrDefault(loc)
+	return Weton{
+		Weekday: t.In(loc).Weekday(),
+		Pasaran: PasaranOf(t, loc),
+	}
+}
+
+// WetonDay day of the 35 day weton cycle (0..34) of the calendar day of t in loc, 0 is Minggu Legi
+func WetonDay(t time.Time, loc *time.Location) int {
+	return floorMod(nthDayIn(t, locationOrDefault(loc))-mingguLegiDay, 35)
+}
+
+var wukuNames = [30]string{
+	"Sinta", "Landep", "Ukir", "Kulantir", "Tolu", "Gumbreg", "Wariga", "Warigadean", "Julungwangi", "Sungsang",
+	"Dungulan", "Kuningan", "Langkir", "Medangsia", "Pujut", "Pahang", "Krulut", "Merakih", "Tambir", "Medangkungan",
+	"Matal", "Uye", "Menail", "Prangbakat", "Bala", "Ugu", "Wayang", "Kelawu", "Dukut", "Watugunung",
+}
+
+// Wuku one of the 30 seven day weeks of the 210 day pawukon cycle, e.g. Galungan is Rabu Kliwon of Dungulan
+type Wuku int
+
+func (w Wuku) String() string {
+	return wukuNames[floorMod(int(w), 30)]
+}
+
+// PawukonDay day of the 210 day pawukon cycle (0..209) of the calendar day of t in loc, 0 is Minggu of wuku Sinta
+func PawukonDay(t time.Time, loc *time.Location) int {
+	return floorMod(nthDayIn(t, locationOrDefault(loc))-sintaDay, 210)
+}
+
+// WukuOf wuku of the calendar day of t in loc
+func WukuOf(t time.Time, loc *time.Location) Wuku {
+	return Wuku(PawukonDay(t, loc) / 7)
+}
+
+// JavaneseDate a date in the Javanese calendar of Sultan Agung (Anno Javanico), Month 1 is Sura
+type JavaneseDate struct {
+	Year  int
+	Month int
+	Day   int
+}
+
+var javaneseMonthNames = [12]string{
+	"Sura", "Sapar", "Mulud", "Bakdamulud", "Jumadilawal", "Jumadilakir",
+	"Rejeb", "Ruwah", "Pasa", "Sawal", "Sela", "Besar",
+}
+
+// String e.g. "1 Sura 1957"
+func (d JavaneseDate) String() string {
+	month := ""
+	if d.Month >= 1 && d.Month <= 12 {
+		month = javaneseMonthNames[d.Month-1]
+	}
+	return fmt.Sprintf("%d %s %d", d.Day, month, d.Year)
+}
+
+// javaneseYearOffset 1 Sura 1555 AJ is 1 Muharram 1043 AH
+const javaneseYearOffset = 1555 - 1043
+
+// JavaneseDate Javanese date of the calendar day of t, months and days follow the Hijri months of c
+// like the Javanese calendar since 1633. Use Adjustment to follow the official 1 Sura when it is a day apart
+func (c HijriCalendar) JavaneseDate(t time.Time) JavaneseDate {
+	h := c.Date(t)
+	return JavaneseDate{Year: h.Year + javaneseYearOffset, Month: h.Month, Day: h.Day}
+}
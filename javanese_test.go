@@ -0,0 +1,74 @@
+package timeutils_go_test
+
+import (
+	"testing"
+	"time"
+
+	timeutilsgo "github.com/harryosmar/timeutils-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWetonOf(t *testing.T) {
+	testData := []struct {
+		name            string
+		t               time.Time
+		expectedWeton   string
+		expectedNeptu   int
+		expectedWuku    string
+		expectedPawukon int
+	}{
+		{name: "proklamasi", t: parseRFC3339("1945-08-17T10:00:00+07:00"), expectedWeton: "Jumat Legi", expectedNeptu: 11, expectedWuku: "Menail", expectedPawukon: 159},
+		{name: "galungan", t: parseRFC3339("2024-02-28T00:00:00+07:00"), expectedWeton: "Rabu Kliwon", expectedNeptu: 15, expectedWuku: "Dungulan", expectedPawukon: 73},
+		{name: "previous galungan", t: parseRFC3339("2023-08-02T12:00:00+07:00"), expectedWeton: "Rabu Kliwon", expectedNeptu: 15, expectedWuku: "Dungulan", expectedPawukon: 73},
+		{name: "kuningan", t: parseRFC3339("2024-03-09T23:59:59+07:00"), expectedWeton: "Sabtu Kliwon", expectedNeptu: 17, expectedWuku: "Kuningan", expectedPawukon: 83},
+		{name: "first day of pawukon", t: parseRFC3339("2023-12-17T00:00:00+07:00"), expectedWeton: "Minggu Pahing", expectedNeptu: 14, expectedWuku: "Sinta", expectedPawukon: 0},
+		{name: "use jakarta calendar day", t: parseRFC3339("2024-02-27T17:00:00Z"), expectedWeton: "Rabu Kliwon", expectedNeptu: 15, expectedWuku: "Dungulan", expectedPawukon: 73},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			weton := timeutilsgo.WetonOf(tt.t, nil)
+			assert.Equal(t, tt.expectedWeton, weton.String())
+			assert.Equal(t, tt.expectedNeptu, weton.Neptu())
+			assert.Equal(t, tt.expectedWuku, timeutilsgo.WukuOf(tt.t, nil).String())
+			assert.Equal(t, tt.expectedPawukon, timeutilsgo.PawukonDay(tt.t, nil))
+		})
+	}
+}
+
+func TestWetonDay(t *testing.T) {
+	start := parseRFC3339("1900-01-01T00:00:00+07:00")
+	for i := 0; i < 365*200; i += 3 {
+		d := start.AddDate(0, 0, i)
+		day := timeutilsgo.WetonDay(d, nil)
+		weton := timeutilsgo.WetonOf(d, nil)
+		if time.Weekday(day%7) != weton.Weekday || timeutilsgo.Pasaran(day%5) != weton.Pasaran {
+			t.Fatalf("%v weton day %d does not match %v", d, day, weton)
+		}
+		if timeutilsgo.WetonDay(d.AddDate(0, 0, 35), nil) != day {
+			t.Fatalf("%v weton does not repeat after 35 days", d)
+		}
+	}
+}
+
+func TestHijriCalendar_JavaneseDate(t *testing.T) {
+	testData := []struct {
+		name           string
+		calendar       timeutilsgo.HijriCalendar
+		t              time.Time
+		expectedResult string
+	}{
+		{name: "1 sura 1957", calendar: timeutilsgo.TabularHijri, t: parseRFC3339("2023-07-19T00:00:00+07:00"), expectedResult: "1 Sura 1957"},
+		{name: "last day of 1956", calendar: timeutilsgo.TabularHijri, t: parseRFC3339("2023-07-18T23:59:59+07:00"), expectedResult: "29 Besar 1956"},
+		{name: "tabular 1 sura 1958", calendar: timeutilsgo.TabularHijri, t: parseRFC3339("2024-07-08T08:00:00+07:00"), expectedResult: "1 Sura 1958"},
+		{name: "official 1 sura 1958", calendar: timeutilsgo.HijriCalendar{Adjustment: 1}, t: parseRFC3339("2024-07-07T08:00:00+07:00"), expectedResult: "1 Sura 1958"},
+		{name: "use jakarta calendar day", calendar: timeutilsgo.HijriCalendar{Adjustment: 1}, t: parseRFC3339("2024-07-06T17:00:00Z"), expectedResult: "1 Sura 1958"},
+		{name: "first year", calendar: timeutilsgo.TabularHijri, t: parseRFC3339("1633-07-08T12:00:00+07:00"), expectedResult: "1 Sura 1555"},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedResult, tt.calendar.JavaneseDate(tt.t).String())
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package timeutils_go
+
+import (
+	"fmt"
+	"time"
+)
+
+// Locale language used by the humanize helpers, default to English
+type Locale int
+
+const (
+	LocaleEnglish Locale = iota
+	LocaleIndonesian
+)
+
+type localeText struct {
+	past       string
+	future     string
+	fewSeconds string
+	// units indexed by Unit, [0] used for 1 and [1] for n
+	units     [Year + 1][2]string
+	today     string
+	yesterday string
+	tomorrow  string
+	lastWeek  string
+	thisWeek  string
+	clock     string
+	date      string
+	weekdays  [7]string
+	// duration units indexed by Unit, short is a suffix and long [0] singular [1] plural.
+	// every suffix is read back by ParseDuration so it must not be used by another unit in any locale
+	durationShort [Year + 1]string
+	durationLong  [Year + 1][2]string
+	hijriMonths   [12]string
+	hijriEra      string
+}
+
+var localeTexts = map[Locale]*localeText{
+	LocaleEnglish: {
+		past:       "%s ago",
+		future:     "in %s",
+		fewSeconds: "a few seconds",
+		units: [Year + 1][2]string{
+			Second: {"a second", "%d seconds"},
+			Minute: {"a minute", "%d minutes"},
+			Hour:   {"an hour", "%d hours"},
+			Day:    {"a day", "%d days"},
+			Week:   {"a week", "%d weeks"},
+			Month:  {"a month", "%d months"},
+			Year:   {"a year", "%d years"},
+		},
+		today:     "today at %s",
+		yesterday: "yesterday at %s",
+		tomorrow:  "tomorrow at %s",
+		lastWeek:  "last %s at %s",
+		thisWeek:  "%s at %s",
+		clock:     "15:04",
+		date:      "01/02/2006",
+		weekdays:  [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		durationShort: [Year + 1]string{
+			Second: "s", Minute: "m", Hour: "h", Day: "d", Week: "w",
+		},
+		durationLong: [Year + 1][2]string{
+			Second: {"second", "seconds"},
+			Minute: {"minute", "minutes"},
+			Hour:   {"hour", "hours"},
+			Day:    {"day", "days"},
+			Week:   {"week", "weeks"},
+		},
+		hijriMonths: [12]string{
+			"Muharram", "Safar", "Rabi' al-Awwal", "Rabi' al-Thani", "Jumada al-Awwal", "Jumada al-Thani",
+			"Rajab", "Sha'ban", "Ramadan", "Shawwal", "Dhu al-Qi'dah", "Dhu al-Hijjah",
+		},
+		hijriEra: "AH",
+	},
+	LocaleIndonesian: {
+		past:       "%s yang lalu",
+		future:     "dalam %s",
+		fewSeconds: "beberapa detik",
+		units: [Year + 1][2]string{
+			Second: {"sedetik", "%d detik"},
+			Minute: {"semenit", "%d menit"},
+			Hour:   {"sejam", "%d jam"},
+			Day:    {"sehari", "%d hari"},
+			Week:   {"seminggu", "%d minggu"},
+			Month:  {"sebulan", "%d bulan"},
+			Year:   {"setahun", "%d tahun"},
+		},
+		today:     "hari ini pukul %s",
+		yesterday: "kemarin pukul %s",
+		tomorrow:  "besok pukul %s",
+		lastWeek:  "%s lalu pukul %s",
+		thisWeek:  "%s pukul %s",
+		clock:     "15.04",
+		date:      "02/01/2006",
+		weekdays:  [7]string{"Minggu", "Senin", "Selasa", "Rabu", "Kamis", "Jumat", "Sabtu"},
+		durationShort: [Year + 1]string{
+			Second: "dtk", Minute: "mnt", Hour: "j", Day: "hri", Week: "mg",
+		},
+		durationLong: [Year + 1][2]string{
+			Second: {"detik", "detik"},
+			Minute: {"menit", "menit"},
+			Hour:   {"jam", "jam"},
+			Day:    {"hari", "hari"},
+			Week:   {"minggu", "minggu"},
+		},
+		hijriMonths: [12]string{
+			"Muharram", "Safar", "Rabiulawal", "Rabiulakhir", "Jumadilawal", "Jumadilakhir",
+			"Rajab", "Syakban", "Ramadan", "Syawal", "Zulkaidah", "Zulhijah",
+		},
+		hijriEra: "H",
+	},
+}
+
+func (l Locale) text() *localeText {
+	if text, ok := localeTexts[l]; ok {
+		return text
+	}
+	return localeTexts[LocaleEnglish]
+}
+
+// unit format n of u, e.g. "a minute", "3 jam"
+func (l Locale) unit(u Unit, n int) string {
+	names := l.text().units[u]
+	if n == 1 {
+		return names[0]
+	}
+	return fmt.Sprintf(names[1], n)
+}
+
+func (l Locale) weekday(d time.Weekday) string {
+	return l.text().weekdays[d]
+}
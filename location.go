@@ -0,0 +1,50 @@
+package timeutils_go
+
+import (
+	"sync"
+	"time"
+)
+
+var (
+	witaLocation = time.FixedZone("WITA", 8*3600)
+	witLocation  = time.FixedZone("WIT", 9*3600)
+)
+
+// fallbackLocations Indonesian zones have no DST since 1964 so fixed offsets are used when tzdata is missing
+var fallbackLocations = map[string]*time.Location{
+	"WIB":                jakartaLocation,
+	"WITA":               witaLocation,
+	"WIT":                witLocation,
+	"Asia/Jakarta":       jakartaLocation,
+	"Asia/Pontianak":     jakartaLocation,
+	"Asia/Makassar":      witaLocation,
+	"Asia/Ujung_Pandang": witaLocation,
+	"Asia/Jayapura":      witLocation,
+}
+
+// LoadLocation same as time.LoadLocation but fall back to a fixed offset for WIB, WITA and WIT
+// (by abbreviation or IANA name) when the system has no tzdata and the tzdata sub-package is not imported.
+// The fallback loses offsets before 1964, error wrap ErrUnknownLocation.
+// Loaded locations are cached by name so only the first call read tzdata
+func LoadLocation(name string) (*time.Location, error) {
+	if cached, ok := locationCache.Load(name); ok {
+		return cached.(*time.Location), nil
+	}
+
+	loc, err := loadLocation(name)
+	if err != nil {
+		fallback, ok := fallbackLocations[name]
+		if !ok {
+			return nil, unknownLocationError(name, err)
+		}
+		loc = fallback
+	}
+	locationCache.Store(name, loc)
+	return loc, nil
+}
+
+// loadLocation tzdata loader, replaced in tests to run without tzdata
+var loadLocation = time.LoadLocation
+
+// locationCache name to *time.Location, unknown names are not cached so it is bounded by the tzdata zone count
+var locationCache sync.Map
@@ -0,0 +1,90 @@
+package timeutils_go_test
+
+import (
+	"testing"
+	"time"
+
+	timeutilsgo "github.com/harryosmar/timeutils-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadLocation(t *testing.T) {
+	ts := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
+	testData := []struct {
+		name           string
+		expectedResult string
+		expectedErr    error
+	}{
+		{name: "Asia/Jakarta", expectedResult: "2024-07-01T07:00:00+07:00 WIB"},
+		{name: "Asia/Makassar", expectedResult: "2024-07-01T08:00:00+08:00 WITA"},
+		{name: "WIB", expectedResult: "2024-07-01T07:00:00+07:00 WIB"},
+		{name: "WITA", expectedResult: "2024-07-01T08:00:00+08:00 WITA"},
+		{name: "WIT", expectedResult: "2024-07-01T09:00:00+09:00 WIT"},
+		{name: "Mars/Olympus_Mons", expectedErr: timeutilsgo.ErrUnknownLocation},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			loc, err := timeutilsgo.LoadLocation(tt.name)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, ts.In(loc).Format(time.RFC3339+" MST"))
+		})
+	}
+
+	actual, err := timeutilsgo.Format(timeutilsgo.FormatParam{T: ts, Location: "WITA", Format: "15:04 MST"})
+	assert.NoError(t, err)
+	assert.Equal(t, "08:00 WITA", actual)
+}
+
+func TestLoadLocation_Fallback(t *testing.T) {
+	restore := timeutilsgo.StubMissingTZData()
+	defer restore()
+
+	ts := time.Date(1950, 1, 1, 0, 0, 0, 0, time.UTC)
+	testData := []struct {
+		name           string
+		expectedResult string
+		expectedErr    error
+	}{
+		{name: "WIB", expectedResult: "1950-01-01T07:00:00+07:00 WIB"},
+		{name: "WITA", expectedResult: "1950-01-01T08:00:00+08:00 WITA"},
+		{name: "WIT", expectedResult: "1950-01-01T09:00:00+09:00 WIT"},
+		// the fixed offset lose the +07:30 Jakarta used before 1964
+		{name: "Asia/Jakarta", expectedResult: "1950-01-01T07:00:00+07:00 WIB"},
+		{name: "Asia/Makassar", expectedResult: "1950-01-01T08:00:00+08:00 WITA"},
+		{name: "Asia/Jayapura", expectedResult: "1950-01-01T09:00:00+09:00 WIT"},
+		{name: "America/New_York", expectedErr: timeutilsgo.ErrUnknownLocation},
+		{name: "Mars/Olympus_Mons", expectedErr: timeutilsgo.ErrUnknownLocation},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			loc, err := timeutilsgo.LoadLocation(tt.name)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, ts.In(loc).Format(time.RFC3339+" MST"))
+		})
+	}
+}
+
+// TestFormat_WithoutTZData the formatting helpers on a distroless image, the stubbed loader fail like a missing tzdata
+func TestFormat_WithoutTZData(t *testing.T) {
+	defer timeutilsgo.StubMissingTZData()()
+
+	ts := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
+	actual, err := timeutilsgo.FormatDate(ts)
+	assert.NoError(t, err)
+	assert.Equal(t, "01 Jul 2024 07:00 WIB", actual)
+	actual, err = timeutilsgo.Format(timeutilsgo.FormatParam{T: ts, Location: "Asia/Makassar", Format: time.DateTime})
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-07-01 08:00:00", actual)
+	_, err = timeutilsgo.Format(timeutilsgo.FormatParam{T: ts, Location: "America/New_York"})
+	assert.ErrorIs(t, err, timeutilsgo.ErrUnknownLocation)
+}
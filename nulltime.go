@@ -0,0 +1,155 @@
+package timeutils_go
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"time"
+)
+
+const dateLayout = "2006-01-02"
+
+// NullTime time that may be unset, the zero value is unset.
+// Unset marshal to JSON null and is stored as SQL NULL, a set value marshal as RFC3339 and is stored like JakartaDateTime
+type NullTime struct {
+	Time  time.Time
+	Valid bool
+}
+
+// NewNullTime set unless t is the zero time
+func NewNullTime(t time.Time) NullTime {
+	return NullTime{Time: t, Valid: !t.IsZero()}
+}
+
+// Format t in Asia/Jakarta with layout, empty when unset
+func (n NullTime) Format(layout string) string {
+	if !n.Valid {
+		return ""
+	}
+	// Asia/Jakarta always load thanks to the fixed offset fallback and a set time is not zero
+	s, _ := WIB.Format(n.Time, layout)
+	return s
+}
+
+// String RFC3339 in Asia/Jakarta, empty when unset
+func (n NullTime) String() string {
+	return n.Format(time.RFC3339)
+}
+
+func (n NullTime) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return n.Time.MarshalJSON()
+}
+
+func (n *NullTime) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		*n = NullTime{}
+		return nil
+	}
+	var t time.Time
+	if err := t.UnmarshalJSON(b); err != nil {
+		return newParseError(string(b), time.RFC3339, ErrInvalidDateTime, err)
+	}
+	*n = NewNullTime(t)
+	return nil
+}
+
+// Scan same as JakartaDateTime, a time.Time or a DATETIME []byte or string is read as Asia/Jakarta wall clock
+// so the value read back does not depend on the driver parseTime setting
+func (n *NullTime) Scan(src any) error {
+	var d JakartaDateTime
+	if err := d.Scan(src); err != nil {
+		return err
+	}
+	*n = NewNullTime(d.Time)
+	return nil
+}
+
+// Value "2006-01-02 15:04:05[.fraction]" Asia/Jakarta wall clock like JakartaDateTime, nil when unset
+func (n NullTime) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return JakartaDateTime{Time: n.Time}.Value()
+}
+
+// NullDate calendar date that may be unset, Date is midnight in Asia/Jakarta and the zero value is unset.
+// Unset marshal to JSON null and is stored as SQL NULL, a set value marshal as "2006-01-02"
+type NullDate struct {
+	Date  time.Time
+	Valid bool
+}
+
+// NewNullDate calendar date of t in Asia/Jakarta, unset when t is the zero time
+func NewNullDate(t time.Time) NullDate {
+	if t.IsZero() {
+		return NullDate{}
+	}
+	return NullDate{Date: Floor(t, Day, jakartaLocation), Valid: true}
+}
+
+// String "2006-01-02", empty when unset
+func (n NullDate) String() string {
+	if !n.Valid {
+		return ""
+	}
+	return n.Date.In(jakartaLocation).Format(dateLayout)
+}
+
+func (n NullDate) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(`"` + n.String() + `"`), nil
+}
+
+func (n *NullDate) UnmarshalJSON(b []byte) error {
+	s := string(b)
+	if s == "null" {
+		*n = NullDate{}
+		return nil
+	}
+	if len(s) < 2 || s[0] != '"' || s[len(s)-1] != '"' {
+		return newParseError(s, dateLayout, ErrInvalidDateTime, fmt.Errorf("expected a JSON string"))
+	}
+	return n.parse(s[1 : len(s)-1])
+}
+
+// Scan accept nil, time.Time whose date is taken as is, and "2006-01-02" []byte or string
+func (n *NullDate) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*n = NullDate{}
+		return nil
+	case time.Time:
+		*n = NewNullDate(sameWallClock(v, jakartaLocation))
+		return nil
+	case []byte:
+		return n.parse(string(v))
+	case string:
+		return n.parse(v)
+	}
+	return fmt.Errorf("%w: cannot scan %T into %T", ErrInvalidDateTime, src, n)
+}
+
+func (n *NullDate) parse(s string) error {
+	if s == "0000-00-00" {
+		*n = NullDate{}
+		return nil
+	}
+	t, err := time.ParseInLocation(dateLayout, s, jakartaLocation)
+	if err != nil {
+		return newParseError(s, dateLayout, ErrInvalidDateTime, err)
+	}
+	*n = NullDate{Date: t, Valid: true}
+	return nil
+}
+
+// Value "2006-01-02", nil when unset
+func (n NullDate) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.String(), nil
+}
@@ -0,0 +1,153 @@
+package timeutils_go_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	timeutilsgo "github.com/harryosmar/timeutils-go"
+	"github.com/stretchr/testify/assert"
+)
+
+type order struct {
+	PaidAt    timeutilsgo.NullTime `json:"paid_at"`
+	DeliverOn timeutilsgo.NullDate `json:"deliver_on"`
+}
+
+func TestNullTime_JSON(t *testing.T) {
+	testData := []struct {
+		name           string
+		order          order
+		expectedResult string
+	}{
+		{
+			name:           "unset",
+			expectedResult: `{"paid_at":null,"deliver_on":null}`,
+		},
+		{
+			name: "set",
+			order: order{
+				PaidAt:    timeutilsgo.NewNullTime(parseRFC3339("2024-07-01T08:30:00+07:00")),
+				DeliverOn: timeutilsgo.NewNullDate(parseRFC3339("2024-07-02T20:00:00Z")),
+			},
+			expectedResult: `{"paid_at":"2024-07-01T08:30:00+07:00","deliver_on":"2024-07-03"}`,
+		},
+		{
+			name: "zero time is unset",
+			order: order{
+				PaidAt:    timeutilsgo.NewNullTime(time.Time{}),
+				DeliverOn: timeutilsgo.NewNullDate(time.Time{}),
+			},
+			expectedResult: `{"paid_at":null,"deliver_on":null}`,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := json.Marshal(tt.order)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, string(actual))
+
+			var back order
+			assert.NoError(t, json.Unmarshal(actual, &back))
+			assert.Equal(t, tt.order.PaidAt.Valid, back.PaidAt.Valid)
+			assert.True(t, tt.order.PaidAt.Time.Equal(back.PaidAt.Time))
+			assert.Equal(t, tt.order.DeliverOn.String(), back.DeliverOn.String())
+		})
+	}
+
+	var o order
+	assert.ErrorIs(t, json.Unmarshal([]byte(`{"deliver_on":"03/07/2024"}`), &o), timeutilsgo.ErrInvalidDateTime)
+	assert.ErrorIs(t, json.Unmarshal([]byte(`{"deliver_on":20240703}`), &o), timeutilsgo.ErrInvalidDateTime)
+}
+
+func TestNullTime_SQL(t *testing.T) {
+	db := openStub(t)
+	for _, v := range []any{
+		timeutilsgo.NullTime{},
+		timeutilsgo.NewNullTime(parseRFC3339("2024-07-01T01:30:00Z")),
+		"2024-07-01 08:30:00",
+		// DATETIME read by a driver with parseTime=true and the default UTC loc
+		time.Date(2024, 7, 1, 8, 30, 0, 0, time.UTC),
+	} {
+		_, err := db.Exec("INSERT", v)
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, "2024-07-01 08:30:00", stub.rows[t.Name()][1])
+
+	rows, err := db.Query("SELECT")
+	assert.NoError(t, err)
+	defer rows.Close()
+
+	var actual []string
+	for rows.Next() {
+		var n timeutilsgo.NullTime
+		assert.NoError(t, rows.Scan(&n))
+		actual = append(actual, n.Format("2006-01-02 15:04:05 MST"))
+	}
+	assert.Equal(t, []string{"", "2024-07-01 08:30:00 WIB", "2024-07-01 08:30:00 WIB", "2024-07-01 08:30:00 WIB"}, actual)
+}
+
+func TestNullTime_RoundTrip(t *testing.T) {
+	for _, ts := range []time.Time{
+		parseRFC3339("2024-07-01T00:00:00Z"),
+		parseRFC3339("1950-01-01T00:00:00Z"),
+		parseRFC3339("2024-07-01T00:00:00.123456Z"),
+	} {
+		value, err := timeutilsgo.NewNullTime(ts).Value()
+		assert.NoError(t, err)
+
+		var fromString, fromBytes timeutilsgo.NullTime
+		assert.NoError(t, fromString.Scan(value))
+		assert.NoError(t, fromBytes.Scan([]byte(value.(string))))
+		assert.True(t, fromString.Valid)
+		assert.True(t, fromString.Time.Equal(ts), fromString.String())
+		assert.True(t, fromBytes.Time.Equal(ts), fromBytes.String())
+	}
+}
+
+func TestNullDate_SQL(t *testing.T) {
+	db := openStub(t)
+	for _, v := range []any{
+		timeutilsgo.NullDate{},
+		timeutilsgo.NewNullDate(parseRFC3339("2024-07-01T23:30:00+07:00")),
+		time.Date(2024, 7, 2, 0, 0, 0, 0, time.UTC),
+		[]byte("0000-00-00"),
+	} {
+		_, err := db.Exec("INSERT", v)
+		assert.NoError(t, err)
+	}
+
+	rows, err := db.Query("SELECT")
+	assert.NoError(t, err)
+	defer rows.Close()
+
+	var actual []string
+	for rows.Next() {
+		var n timeutilsgo.NullDate
+		assert.NoError(t, rows.Scan(&n))
+		actual = append(actual, n.String())
+	}
+	assert.Equal(t, []string{"", "2024-07-01", "2024-07-02", ""}, actual)
+
+	var n timeutilsgo.NullDate
+	assert.ErrorIs(t, n.Scan("2024-02-30"), timeutilsgo.ErrInvalidDateTime)
+}
+
+func TestFormat_ZeroTime(t *testing.T) {
+	_, err := timeutilsgo.FormatDate(time.Time{})
+	assert.ErrorIs(t, err, timeutilsgo.ErrZeroTime)
+	_, err = timeutilsgo.FormatMySQLDateJakartaTimezone(time.Time{})
+	assert.ErrorIs(t, err, timeutilsgo.ErrZeroTime)
+	_, err = timeutilsgo.FormatMySQLDateUTCTimezone(time.Time{})
+	assert.ErrorIs(t, err, timeutilsgo.ErrZeroTime)
+	_, err = timeutilsgo.WITA.FormatMySQLDate(time.Time{})
+	assert.ErrorIs(t, err, timeutilsgo.ErrZeroTime)
+
+	now := parseRFC3339("2024-07-01T08:30:00+07:00")
+	assert.Equal(t, "", timeutilsgo.Humanize(time.Time{}, now, timeutilsgo.LocaleEnglish))
+	assert.Equal(t, "", timeutilsgo.CalendarTime(timeutilsgo.RelativeParam{T: now}))
+	assert.Equal(t, "", timeutilsgo.TabularHijri.Format(time.Time{}, timeutilsgo.LocaleEnglish))
+	assert.Equal(t, "", timeutilsgo.CalendarQuarters.Label(time.Time{}))
+	assert.Equal(t, "", timeutilsgo.NullTime{}.String())
+}
@@ -0,0 +1,193 @@
+package timeutils_go
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Period an ISO 8601 duration such as "P1Y2M10DT2H30M".
+// Years, Months and Days follow the calendar of a location, Hours, Minutes, Seconds and Nanoseconds are exact
+type Period struct {
+	Years       int
+	Months      int
+	Days        int
+	Hours       int
+	Minutes     int
+	Seconds     int
+	Nanoseconds int
+}
+
+// ParsePeriod parse an ISO 8601 duration, weeks are stored as 7 days and a leading "-" negate every component
+func ParsePeriod(s string) (Period, error) {
+	in := strings.TrimSpace(s)
+	negative := false
+	if in != "" && (in[0] == '-' || in[0] == '+') {
+		negative = in[0] == '-'
+		in = in[1:]
+	}
+
+	c, err := parseISODuration(in)
+	if err != nil {
+		return Period{}, newParseError(s, isoDurationLayout, ErrInvalidPeriod, err)
+	}
+	p := Period{
+		Years:       int(c.years),
+		Months:      int(c.months),
+		Days:        int(c.weeks*7 + c.days),
+		Hours:       int(c.hours),
+		Minutes:     int(c.minutes),
+		Seconds:     int(c.seconds),
+		Nanoseconds: int(c.nanos),
+	}
+	if negative {
+		return p.Negate(), nil
+	}
+	return p, nil
+}
+
+func (p Period) IsZero() bool {
+	return p == Period{}
+}
+
+func (p Period) Negate() Period {
+	return Period{
+		Years:       -p.Years,
+		Months:      -p.Months,
+		Days:        -p.Days,
+		Hours:       -p.Hours,
+		Minutes:     -p.Minutes,
+		Seconds:     -p.Seconds,
+		Nanoseconds: -p.Nanoseconds,
+	}
+}
+
+// Exact the hours, minutes, seconds and nanoseconds part of p
+func (p Period) Exact() time.Duration {
+	return time.Duration(p.Hours)*time.Hour +
+		time.Duration(p.Minutes)*time.Minute +
+		time.Duration(p.Seconds)*time.Second +
+		time.Duration(p.Nanoseconds)
+}
+
+// String format p as ISO 8601, a period with only negative components is prefixed by "-", zero is "PT0S".
+// ISO 8601 has no sign per component, so only a period whose components share one sign, like those from
+// ParsePeriod and Between, round trip through ParsePeriod. A mixed period such as Period{Years: 1, Days: -3}
+// is written "P1Y-3D" and ParsePeriod reject it
+func (p Period) String() string {
+	if p.IsZero() {
+		return "PT0S"
+	}
+
+	sign := ""
+	if p.Years <= 0 && p.Months <= 0 && p.Days <= 0 && p.Hours <= 0 && p.Minutes <= 0 && p.Seconds <= 0 && p.Nanoseconds <= 0 {
+		sign = "-"
+		p = p.Negate()
+	}
+
+	var b strings.Builder
+	b.WriteString(sign)
+	b.WriteString("P")
+	for _, c := range []struct {
+		n          int
+		designator string
+	}{{p.Years, "Y"}, {p.Months, "M"}, {p.Days, "D"}} {
+		if c.n != 0 {
+			b.WriteString(strconv.Itoa(c.n) + c.designator)
+		}
+	}
+
+	if p.Hours == 0 && p.Minutes == 0 && p.Seconds == 0 && p.Nanoseconds == 0 {
+		return b.String()
+	}
+	b.WriteString("T")
+	if p.Hours != 0 {
+		b.WriteString(strconv.Itoa(p.Hours) + "H")
+	}
+	if p.Minutes != 0 {
+		b.WriteString(strconv.Itoa(p.Minutes) + "M")
+	}
+	if p.Seconds != 0 || p.Nanoseconds != 0 {
+		sec := time.Duration(p.Seconds)*time.Second + time.Duration(p.Nanoseconds)
+		b.WriteString(strconv.FormatFloat(sec.Seconds(), 'f', -1, 64) + "S")
+	}
+	return b.String()
+}
+
+// addMonths add n months to t keeping the wall clock, the day is clamped to the end of the month (Jan 31 + 1 month = Feb 28)
+func addMonths(t time.Time, n int, loc *time.Location) time.Time {
+	year, month, day := t.Date()
+	hour, minute, sec := t.Clock()
+	total := year*12 + int(month) - 1 + n
+	year, month = floorDiv(total, 12), time.Month(floorMod(total, 12)+1)
+	day = min(day, daysInMonth(year, month))
+	return wallTime(year, month, day, hour, minute, sec, t.Nanosecond(), loc)
+}
+
+func daysInMonth(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// AddTo add p to t in loc, calendar components are added first with month-end clamping then the exact part.
+// loc default to Asia/Jakarta
+func (p Period) AddTo(t time.Time, loc *time.Location) time.Time {
+	loc = locationOrDefault(loc)
+	t = addMonths(t.In(loc), p.Years*12+p.Months, loc)
+	if p.Days != 0 {
+		year, month, day := t.Date()
+		hour, minute, sec := t.Clock()
+		t = wallTime(year, month, day+p.Days, hour, minute, sec, t.Nanosecond(), loc)
+	}
+	return t.Add(p.Exact())
+}
+
+func (p Period) SubtractFrom(t time.Time, loc *time.Location) time.Time {
+	return p.Negate().AddTo(t, loc)
+}
+
+// Between the normalised period from a to b in loc, so that Between(a, b, loc).AddTo(a, loc) equal b.
+// When b is before a every component is negative, counted backward from a
+func Between(a time.Time, b time.Time, loc *time.Location) Period {
+	loc = locationOrDefault(loc)
+	a, b = a.In(loc), b.In(loc)
+
+	// step is the direction from a to b, past reports whether x went beyond b in that direction
+	step := 1
+	if b.Before(a) {
+		step = -1
+	}
+	past := func(x time.Time) bool {
+		if step > 0 {
+			return x.After(b)
+		}
+		return x.Before(b)
+	}
+
+	months := (b.Year()-a.Year())*12 + int(b.Month()-a.Month())
+	mid := addMonths(a, months, loc)
+	for months*step > 0 && past(mid) {
+		months -= step
+		mid = addMonths(a, months, loc)
+	}
+
+	days := nthDayIn(b, loc) - nthDayIn(mid, loc)
+	next := Period{Days: days}.AddTo(mid, loc)
+	for days*step > 0 && past(next) {
+		days -= step
+		next = Period{Days: days}.AddTo(mid, loc)
+	}
+
+	rest := b.Sub(next)
+	p := Period{
+		Years:  months / 12,
+		Months: months % 12,
+		Days:   days,
+	}
+	p.Hours = int(rest / time.Hour)
+	rest %= time.Hour
+	p.Minutes = int(rest / time.Minute)
+	rest %= time.Minute
+	p.Seconds = int(rest / time.Second)
+	p.Nanoseconds = int(rest % time.Second)
+	return p
+}
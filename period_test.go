@@ -0,0 +1,240 @@
+package timeutils_go_test
+
+import (
+	"testing"
+	"time"
+
+	timeutilsgo "github.com/harryosmar/timeutils-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePeriod(t *testing.T) {
+	testData := []struct {
+		name           string
+		input          string
+		expectedResult timeutilsgo.Period
+		expectedString string
+		expectedErr    bool
+	}{
+		{
+			name:           "full",
+			input:          "P1Y2M10DT2H30M",
+			expectedResult: timeutilsgo.Period{Years: 1, Months: 2, Days: 10, Hours: 2, Minutes: 30},
+			expectedString: "P1Y2M10DT2H30M",
+		},
+		{
+			name:           "month",
+			input:          "P1M",
+			expectedResult: timeutilsgo.Period{Months: 1},
+			expectedString: "P1M",
+		},
+		{
+			name:           "minute is not month",
+			input:          "PT1M",
+			expectedResult: timeutilsgo.Period{Minutes: 1},
+			expectedString: "PT1M",
+		},
+		{
+			name:           "weeks",
+			input:          "P2W",
+			expectedResult: timeutilsgo.Period{Days: 14},
+			expectedString: "P14D",
+		},
+		{
+			name:           "negative with fraction",
+			input:          "-P1DT0.25S",
+			expectedResult: timeutilsgo.Period{Days: -1, Nanoseconds: -250000000},
+			expectedString: "-P1DT0.25S",
+		},
+		{
+			name:           "zero",
+			input:          "PT0S",
+			expectedResult: timeutilsgo.Period{},
+			expectedString: "PT0S",
+		},
+		{
+			name:        "empty",
+			input:       "P",
+			expectedErr: true,
+		},
+		{
+			name:        "fraction on days",
+			input:       "P1.5D",
+			expectedErr: true,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := timeutilsgo.ParsePeriod(tt.input)
+			if tt.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, actual)
+			assert.Equal(t, tt.expectedString, actual.String())
+		})
+	}
+}
+
+func TestPeriod_AddTo(t *testing.T) {
+	testData := []struct {
+		name           string
+		t              time.Time
+		period         timeutilsgo.Period
+		expectedResult string
+	}{
+		{
+			name:           "month end clamping",
+			t:              parseRFC3339("2023-01-31T10:00:00+07:00"),
+			period:         timeutilsgo.Period{Months: 1},
+			expectedResult: "2023-02-28T10:00:00+07:00",
+		},
+		{
+			name:           "leap year",
+			t:              parseRFC3339("2024-01-31T10:00:00+07:00"),
+			period:         timeutilsgo.Period{Months: 1},
+			expectedResult: "2024-02-29T10:00:00+07:00",
+		},
+		{
+			name:           "year from leap day",
+			t:              parseRFC3339("2024-02-29T00:00:00+07:00"),
+			period:         timeutilsgo.Period{Years: 1},
+			expectedResult: "2025-02-28T00:00:00+07:00",
+		},
+		{
+			name:           "clamp then days then exact",
+			t:              parseRFC3339("2023-01-31T23:00:00+07:00"),
+			period:         timeutilsgo.Period{Months: 1, Days: 1, Hours: 2},
+			expectedResult: "2023-03-02T01:00:00+07:00",
+		},
+		{
+			name:           "subtract",
+			t:              parseRFC3339("2023-03-31T00:00:00+07:00"),
+			period:         timeutilsgo.Period{Months: -1},
+			expectedResult: "2023-02-28T00:00:00+07:00",
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := tt.period.AddTo(tt.t, nil)
+			assert.Equal(t, tt.expectedResult, actual.Format(time.RFC3339))
+		})
+	}
+}
+
+func TestPeriod_AddToDST(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+	start := time.Date(2024, 3, 9, 12, 0, 0, 0, loc)
+
+	assert.Equal(t, "2024-03-10T12:00:00-04:00", timeutilsgo.Period{Days: 1}.AddTo(start, loc).Format(time.RFC3339))
+	assert.Equal(t, "2024-03-10T13:00:00-04:00", timeutilsgo.Period{Hours: 24}.AddTo(start, loc).Format(time.RFC3339))
+	assert.Equal(t, "2024-03-09T12:00:00-05:00", timeutilsgo.Period{Days: 1}.SubtractFrom(start.AddDate(0, 0, 1), loc).Format(time.RFC3339))
+}
+
+func TestPeriod_StringRoundTrip(t *testing.T) {
+	testData := []struct {
+		name           string
+		p              timeutilsgo.Period
+		expectedString string
+		expectedErr    bool
+	}{
+		{name: "positive", p: timeutilsgo.Period{Years: 1, Days: 3, Seconds: 1, Nanoseconds: 5}, expectedString: "P1Y3DT1.000000005S"},
+		{name: "negative", p: timeutilsgo.Period{Months: -2, Hours: -4}, expectedString: "-P2MT4H"},
+		{name: "between", p: timeutilsgo.Between(parseRFC3339("2023-03-31T00:00:00+07:00"), parseRFC3339("2023-02-28T06:00:00+07:00"), nil), expectedString: "-P30DT18H"},
+		{name: "mixed", p: timeutilsgo.Period{Years: 1, Days: -3}, expectedString: "P1Y-3D", expectedErr: true},
+		{name: "mixed time", p: timeutilsgo.Period{Hours: -1, Minutes: 30}, expectedString: "PT-1H30M", expectedErr: true},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedString, tt.p.String())
+			actual, err := timeutilsgo.ParsePeriod(tt.p.String())
+			if tt.expectedErr {
+				assert.ErrorIs(t, err, timeutilsgo.ErrInvalidPeriod)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.p, actual)
+		})
+	}
+}
+
+func TestBetween(t *testing.T) {
+	testData := []struct {
+		name           string
+		a              time.Time
+		b              time.Time
+		expectedResult string
+	}{
+		{
+			name:           "full",
+			a:              parseRFC3339("2022-01-15T08:00:00+07:00"),
+			b:              parseRFC3339("2023-03-25T10:30:15+07:00"),
+			expectedResult: "P1Y2M10DT2H30M15S",
+		},
+		{
+			name:           "month end",
+			a:              parseRFC3339("2023-01-31T00:00:00+07:00"),
+			b:              parseRFC3339("2023-03-01T00:00:00+07:00"),
+			expectedResult: "P1M1D",
+		},
+		{
+			name:           "less than a day across midnight",
+			a:              parseRFC3339("2023-03-28T22:00:00+07:00"),
+			b:              parseRFC3339("2023-03-29T01:00:00+07:00"),
+			expectedResult: "PT3H",
+		},
+		{
+			name:           "negative",
+			a:              parseRFC3339("2023-03-01T00:00:00+07:00"),
+			b:              parseRFC3339("2023-01-31T00:00:00+07:00"),
+			expectedResult: "-P1M1D",
+		},
+		{
+			name:           "negative month end",
+			a:              parseRFC3339("2023-03-31T00:00:00+07:00"),
+			b:              parseRFC3339("2023-02-28T00:00:00+07:00"),
+			expectedResult: "-P1M",
+		},
+		{
+			name:           "negative full",
+			a:              parseRFC3339("2023-03-25T10:30:15+07:00"),
+			b:              parseRFC3339("2022-01-31T08:00:00+07:00"),
+			expectedResult: "-P1Y1M25DT2H30M15S",
+		},
+		{
+			name:           "same",
+			a:              parseRFC3339("2023-03-01T00:00:00+07:00"),
+			b:              parseRFC3339("2023-03-01T00:00:00+07:00"),
+			expectedResult: "PT0S",
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := timeutilsgo.Between(tt.a, tt.b, nil)
+			assert.Equal(t, tt.expectedResult, actual.String())
+			assert.Equal(t, tt.b.Unix(), actual.AddTo(tt.a, nil).Unix())
+		})
+	}
+}
+
+func TestBetween_AddToInvariant(t *testing.T) {
+	start := parseRFC3339("2023-01-28T00:00:00+07:00")
+	for i := 0; i < 70; i++ {
+		for j := 0; j < 70; j++ {
+			a := start.AddDate(0, 0, i).Add(time.Duration(i) * 7 * time.Hour)
+			b := start.AddDate(0, 0, j*3).Add(time.Duration(j) * 5 * time.Hour)
+			p := timeutilsgo.Between(a, b, nil)
+			if !p.AddTo(a, nil).Equal(b) {
+				t.Fatalf("%v to %v: %v add to %v", a, b, p, p.AddTo(a, nil))
+			}
+		}
+	}
+}
@@ -0,0 +1,199 @@
+package timeutils_go
+
+import (
+	"fmt"
+	"time"
+)
+
+// RetailPattern weeks in each of the three periods of a retail quarter
+type RetailPattern int
+
+const (
+	Pattern454 RetailPattern = iota
+	Pattern445
+	Pattern544
+)
+
+var retailPatternWeeks = map[RetailPattern][3]int{
+	Pattern454: {4, 5, 4},
+	Pattern445: {4, 4, 5},
+	Pattern544: {5, 4, 4},
+}
+
+// RetailYearEnd rule picking the last day of a retail year
+type RetailYearEnd int
+
+const (
+	// RetailYearEndNearest the EndWeekday nearest the last day of YearEndMonth
+	RetailYearEndNearest RetailYearEnd = iota
+	// RetailYearEndLast the last EndWeekday of YearEndMonth
+	RetailYearEndLast
+)
+
+// RetailCalendar 52/53 week retail calendar, the extra week of a 53 week year is added to period 12.
+// YearEndMonth default to January, Location default to Asia/Jakarta,
+// a retail year is numbered like the FiscalCalendar starting the month after YearEndMonth
+type RetailCalendar struct {
+	Pattern      RetailPattern
+	YearEndMonth time.Month
+	EndWeekday   time.Weekday
+	YearEnd      RetailYearEnd
+	Naming       FiscalNaming
+	Location     *time.Location
+}
+
+// NRFCalendar the National Retail Federation 4-5-4 calendar, ending on the Saturday nearest the end of January
+var NRFCalendar = RetailCalendar{
+	Pattern:      Pattern454,
+	YearEndMonth: time.January,
+	EndWeekday:   time.Saturday,
+	YearEnd:      RetailYearEndNearest,
+	Naming:       FiscalNamingStartYear,
+}
+
+// RetailDate position of a day in a retail calendar, Week is the week of the year (1..53)
+type RetailDate struct {
+	Year         int
+	Quarter      int
+	Period       int
+	Week         int
+	WeekOfPeriod int
+}
+
+func (c RetailCalendar) yearEndMonth() time.Month {
+	if c.YearEndMonth < time.January || c.YearEndMonth > time.December {
+		return time.January
+	}
+	return c.YearEndMonth
+}
+
+func (c RetailCalendar) fiscal() FiscalCalendar {
+	return FiscalCalendar{StartMonth: c.yearEndMonth()%12 + 1, Naming: c.Naming, Location: time.UTC}
+}
+
+// yearEndDay day index of the last day of the retail year ending around YearEndMonth of calendar year calYear
+func (c RetailCalendar) yearEndDay(calYear int) int {
+	lastDay := nthDayIn(time.Date(calYear, c.yearEndMonth()+1, 0, 0, 0, 0, 0, time.UTC), time.UTC)
+	weekday := floorMod(lastDay+int(time.Thursday), 7)
+
+	if c.YearEnd == RetailYearEndLast {
+		return lastDay - floorMod(weekday-int(c.EndWeekday), 7)
+	}
+	forward := floorMod(int(c.EndWeekday)-weekday, 7)
+	if forward <= 3 {
+		return lastDay + forward
+	}
+	return lastDay + forward - 7
+}
+
+// anchorYear calendar year of the YearEndMonth closing the retail year
+func (c RetailCalendar) anchorYear(year int) int {
+	fc := c.fiscal()
+	if fc.StartMonth == time.January {
+		return fc.startYearOf(year)
+	}
+	return fc.startYearOf(year) + 1
+}
+
+// days first and last day index of the retail year
+func (c RetailCalendar) days(year int) (first int, last int) {
+	anchor := c.anchorYear(year)
+	return c.yearEndDay(anchor-1) + 1, c.yearEndDay(anchor)
+}
+
+func (c RetailCalendar) daysRange(first int, last int) (gte int64, lte int64) {
+	loc := locationOrDefault(c.Location)
+	return dayStart(first, loc).Unix(), dayStart(last+1, loc).Unix() - 1
+}
+
+// periodWeeks weeks in each of the 12 periods of year
+func (c RetailCalendar) periodWeeks(year int) [12]int {
+	pattern := retailPatternWeeks[c.Pattern]
+	var weeks [12]int
+	for i := range weeks {
+		weeks[i] = pattern[i%3]
+	}
+	if c.Weeks(year) == 53 {
+		weeks[11]++
+	}
+	return weeks
+}
+
+// Weeks 52 or 53
+func (c RetailCalendar) Weeks(year int) int {
+	first, last := c.days(year)
+	return (last - first + 1) / 7
+}
+
+func (c RetailCalendar) Is53WeekYear(year int) bool {
+	return c.Weeks(year) == 53
+}
+
+// YearRange first and last second of the retail year, year is validated by DefaultValidation
+func (c RetailCalendar) YearRange(year int) (gte int64, lte int64, err error) {
+	if err := DefaultValidation.Year(year); err != nil {
+		return 0, 0, err
+	}
+	gte, lte = c.daysRange(c.days(year))
+	return gte, lte, nil
+}
+
+// PeriodRange first and last second of period (1..12) of the retail year
+func (c RetailCalendar) PeriodRange(year int, period int) (gte int64, lte int64, err error) {
+	if err := DefaultValidation.Year(year); err != nil {
+		return 0, 0, err
+	}
+	if period < 1 || period > 12 {
+		return 0, 0, fmt.Errorf("%w: %d is outside 1..12", ErrInvalidRetailPeriod, period)
+	}
+
+	first, _ := c.days(year)
+	weeks := c.periodWeeks(year)
+	for _, w := range weeks[:period-1] {
+		first += w * 7
+	}
+	gte, lte = c.daysRange(first, first+weeks[period-1]*7-1)
+	return gte, lte, nil
+}
+
+// WeekRange first and last second of week (1..52 or 53) of the retail year
+func (c RetailCalendar) WeekRange(year int, week int) (gte int64, lte int64, err error) {
+	if err := DefaultValidation.Year(year); err != nil {
+		return 0, 0, err
+	}
+	if weeks := c.Weeks(year); week < 1 || week > weeks {
+		return 0, 0, fmt.Errorf("%w: %d is outside 1..%d", ErrInvalidWeek, week, weeks)
+	}
+
+	first, _ := c.days(year)
+	first += (week - 1) * 7
+	gte, lte = c.daysRange(first, first+6)
+	return gte, lte, nil
+}
+
+// Locate retail year, quarter, period and week containing t
+func (c RetailCalendar) Locate(t time.Time) RetailDate {
+	loc := locationOrDefault(c.Location)
+	day := nthDayIn(t, loc)
+
+	anchor := t.In(loc).Year()
+	if day > c.yearEndDay(anchor) {
+		anchor++
+	} else if day <= c.yearEndDay(anchor-1) {
+		anchor--
+	}
+	year := c.fiscal().FiscalYearOf(time.Date(anchor, c.yearEndMonth(), 15, 0, 0, 0, 0, time.UTC))
+
+	first, _ := c.days(year)
+	week := (day-first)/7 + 1
+	d := RetailDate{Year: year, Week: week, WeekOfPeriod: week}
+	for i, w := range c.periodWeeks(year) {
+		d.Period = i + 1
+		if d.WeekOfPeriod <= w {
+			break
+		}
+		d.WeekOfPeriod -= w
+	}
+	d.Quarter = (d.Period-1)/3 + 1
+	return d
+}
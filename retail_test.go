@@ -0,0 +1,128 @@
+package timeutils_go_test
+
+import (
+	"testing"
+	"time"
+
+	timeutilsgo "github.com/harryosmar/timeutils-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetailCalendar_YearRange(t *testing.T) {
+	lastSaturdayOfDecember := timeutilsgo.RetailCalendar{
+		Pattern:      timeutilsgo.Pattern445,
+		YearEndMonth: time.December,
+		EndWeekday:   time.Saturday,
+		YearEnd:      timeutilsgo.RetailYearEndLast,
+	}
+	testData := []struct {
+		name          string
+		calendar      timeutilsgo.RetailCalendar
+		year          int
+		expectedRange string
+		expectedWeeks int
+	}{
+		{
+			name:          "nrf 2023 has 53 weeks",
+			calendar:      timeutilsgo.NRFCalendar,
+			year:          2023,
+			expectedRange: "2023-01-29T00:00:00+07:00 - 2024-02-03T23:59:59+07:00",
+			expectedWeeks: 53,
+		},
+		{
+			name:          "nrf 2024",
+			calendar:      timeutilsgo.NRFCalendar,
+			year:          2024,
+			expectedRange: "2024-02-04T00:00:00+07:00 - 2025-02-01T23:59:59+07:00",
+			expectedWeeks: 52,
+		},
+		{
+			name:          "last saturday of december",
+			calendar:      lastSaturdayOfDecember,
+			year:          2024,
+			expectedRange: "2023-12-31T00:00:00+07:00 - 2024-12-28T23:59:59+07:00",
+			expectedWeeks: 52,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			gte, lte, err := tt.calendar.YearRange(tt.year)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedRange, formatRange(gte, lte))
+			assert.Equal(t, tt.expectedWeeks, tt.calendar.Weeks(tt.year))
+			assert.Equal(t, tt.expectedWeeks == 53, tt.calendar.Is53WeekYear(tt.year))
+		})
+	}
+}
+
+func TestRetailCalendar_PeriodRange(t *testing.T) {
+	testData := []struct {
+		name          string
+		year          int
+		period        int
+		expectedRange string
+	}{
+		{name: "first period has 4 weeks", year: 2024, period: 1, expectedRange: "2024-02-04T00:00:00+07:00 - 2024-03-02T23:59:59+07:00"},
+		{name: "second period has 5 weeks", year: 2024, period: 2, expectedRange: "2024-03-03T00:00:00+07:00 - 2024-04-06T23:59:59+07:00"},
+		{name: "third period has 4 weeks", year: 2024, period: 3, expectedRange: "2024-04-07T00:00:00+07:00 - 2024-05-04T23:59:59+07:00"},
+		{name: "53rd week goes to period 12", year: 2023, period: 12, expectedRange: "2023-12-31T00:00:00+07:00 - 2024-02-03T23:59:59+07:00"},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			gte, lte, err := timeutilsgo.NRFCalendar.PeriodRange(tt.year, tt.period)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedRange, formatRange(gte, lte))
+		})
+	}
+
+	_, _, err := timeutilsgo.NRFCalendar.PeriodRange(2024, 13)
+	assert.ErrorIs(t, err, timeutilsgo.ErrInvalidRetailPeriod)
+	_, _, err = timeutilsgo.NRFCalendar.WeekRange(2024, 53)
+	assert.ErrorIs(t, err, timeutilsgo.ErrInvalidWeek)
+
+	gte, lte, err := timeutilsgo.NRFCalendar.WeekRange(2023, 53)
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-01-28T00:00:00+07:00 - 2024-02-03T23:59:59+07:00", formatRange(gte, lte))
+}
+
+func TestRetailCalendar_Locate(t *testing.T) {
+	testData := []struct {
+		name           string
+		t              time.Time
+		expectedResult timeutilsgo.RetailDate
+	}{
+		{
+			name:           "first day",
+			t:              parseRFC3339("2024-02-04T00:00:00+07:00"),
+			expectedResult: timeutilsgo.RetailDate{Year: 2024, Quarter: 1, Period: 1, Week: 1, WeekOfPeriod: 1},
+		},
+		{
+			name:           "second period",
+			t:              parseRFC3339("2024-03-10T12:00:00+07:00"),
+			expectedResult: timeutilsgo.RetailDate{Year: 2024, Quarter: 1, Period: 2, Week: 6, WeekOfPeriod: 2},
+		},
+		{
+			name:           "53rd week in january of the next calendar year",
+			t:              parseRFC3339("2024-02-03T23:59:59+07:00"),
+			expectedResult: timeutilsgo.RetailDate{Year: 2023, Quarter: 4, Period: 12, Week: 53, WeekOfPeriod: 5},
+		},
+		{
+			name:           "use jakarta calendar day",
+			t:              parseRFC3339("2024-02-03T17:00:00Z"),
+			expectedResult: timeutilsgo.RetailDate{Year: 2024, Quarter: 1, Period: 1, Week: 1, WeekOfPeriod: 1},
+		},
+		{
+			name:           "early january belongs to previous year",
+			t:              parseRFC3339("2025-01-05T00:00:00+07:00"),
+			expectedResult: timeutilsgo.RetailDate{Year: 2024, Quarter: 4, Period: 12, Week: 49, WeekOfPeriod: 1},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedResult, timeutilsgo.NRFCalendar.Locate(tt.t))
+		})
+	}
+}
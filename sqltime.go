@@ -0,0 +1,101 @@
+package timeutils_go
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"time"
+)
+
+// mysqlDateTimeLayout DATETIME as written by MySQL, the fraction is optional when parsing and omitted when zero
+const mysqlDateTimeLayout = "2006-01-02 15:04:05.999999999"
+
+// ZoneProvider location of a LocalDateTime, implemented by empty marker types such as WIBZone
+type ZoneProvider interface {
+	Location() *time.Location
+}
+
+// WIBZone Asia/Jakarta, UTC+7 since 1964, the same location as FormatMySQLDateJakartaTimezone
+type WIBZone struct{}
+
+func (WIBZone) Location() *time.Location { return WIB.IANALocation() }
+
+// WITAZone Asia/Makassar, UTC+8 since 1964
+type WITAZone struct{}
+
+func (WITAZone) Location() *time.Location { return WITA.IANALocation() }
+
+// WITZone Asia/Jayapura, UTC+9 since 1964
+type WITZone struct{}
+
+func (WITZone) Location() *time.Location { return WIT.IANALocation() }
+
+// UTCZone UTC
+type UTCZone struct{}
+
+func (UTCZone) Location() *time.Location { return time.UTC }
+
+// LocalDateTime time stored in a zone-less DATETIME column as the wall clock of Z.
+// It implements sql.Scanner and driver.Valuer, NULL scan to the zero time and the zero time is stored as NULL
+type LocalDateTime[Z ZoneProvider] struct {
+	time.Time
+}
+
+// JakartaDateTime DATETIME column holding Asia/Jakarta wall clock
+type JakartaDateTime = LocalDateTime[WIBZone]
+
+// NewLocalDateTime t in the location of Z
+func NewLocalDateTime[Z ZoneProvider](t time.Time) LocalDateTime[Z] {
+	var z Z
+	return LocalDateTime[Z]{Time: t.In(z.Location())}
+}
+
+// Scan accept nil, []byte and string in "2006-01-02 15:04:05[.fraction]" and time.Time.
+// A time.Time wall clock is read as the wall clock of Z whatever location the driver put on it,
+// e.g. go-sql-driver/mysql with parseTime=true return the DATETIME as UTC
+func (d *LocalDateTime[Z]) Scan(src any) error {
+	var z Z
+	loc := z.Location()
+	switch v := src.(type) {
+	case nil:
+		d.Time = time.Time{}
+		return nil
+	case time.Time:
+		d.Time = sameWallClock(v, loc)
+		return nil
+	case []byte:
+		return d.parse(string(v), loc)
+	case string:
+		return d.parse(v, loc)
+	}
+	return fmt.Errorf("%w: cannot scan %T into %T", ErrInvalidDateTime, src, d)
+}
+
+func (d *LocalDateTime[Z]) parse(s string, loc *time.Location) error {
+	if s == "0000-00-00 00:00:00" || s == "0000-00-00" {
+		d.Time = time.Time{}
+		return nil
+	}
+	t, err := time.Parse(mysqlDateTimeLayout, s)
+	if err != nil {
+		return newParseError(s, mysqlDateTimeLayout, ErrInvalidDateTime, err)
+	}
+	d.Time = sameWallClock(t, loc)
+	return nil
+}
+
+// sameWallClock instant in loc whose wall clock read the same as t in its own location
+func sameWallClock(t time.Time, loc *time.Location) time.Time {
+	year, month, day := t.Date()
+	hour, minute, sec := t.Clock()
+	return wallTime(year, month, day, hour, minute, sec, t.Nanosecond(), loc)
+}
+
+// Value "2006-01-02 15:04:05[.fraction]" wall clock in Z, nil for the zero time.
+// The fraction is kept so a DATETIME(3) or DATETIME(6) value scanned by Scan is written back unchanged
+func (d LocalDateTime[Z]) Value() (driver.Value, error) {
+	if d.Time.IsZero() {
+		return nil, nil
+	}
+	var z Z
+	return d.Time.In(z.Location()).Format(mysqlDateTimeLayout), nil
+}
@@ -0,0 +1,188 @@
+package timeutils_go_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	timeutilsgo "github.com/harryosmar/timeutils-go"
+	"github.com/stretchr/testify/assert"
+)
+
+// stubDriver in-memory single column table, Exec append the first argument and Query return every stored value
+type stubDriver struct {
+	mu   sync.Mutex
+	rows map[string][]driver.Value
+}
+
+type stubConn struct {
+	driver *stubDriver
+	table  string
+}
+
+type stubStmt struct {
+	conn  *stubConn
+	query string
+}
+
+type stubRows struct {
+	values []driver.Value
+	i      int
+}
+
+var stub = &stubDriver{rows: map[string][]driver.Value{}}
+
+func init() {
+	sql.Register("timeutils-stub", stub)
+}
+
+func (d *stubDriver) Open(name string) (driver.Conn, error) {
+	return &stubConn{driver: d, table: name}, nil
+}
+
+func (c *stubConn) Prepare(query string) (driver.Stmt, error) {
+	return &stubStmt{conn: c, query: query}, nil
+}
+
+func (c *stubConn) Close() error { return nil }
+
+func (c *stubConn) Begin() (driver.Tx, error) { return nil, errors.New("stub: no transaction") }
+
+func (s *stubStmt) Close() error { return nil }
+
+func (s *stubStmt) NumInput() int { return -1 }
+
+func (s *stubStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.conn.driver.mu.Lock()
+	defer s.conn.driver.mu.Unlock()
+	s.conn.driver.rows[s.conn.table] = append(s.conn.driver.rows[s.conn.table], args[0])
+	return driver.RowsAffected(1), nil
+}
+
+func (s *stubStmt) Query(args []driver.Value) (driver.Rows, error) {
+	s.conn.driver.mu.Lock()
+	defer s.conn.driver.mu.Unlock()
+	return &stubRows{values: append([]driver.Value(nil), s.conn.driver.rows[s.conn.table]...)}, nil
+}
+
+func (r *stubRows) Columns() []string { return []string{"value"} }
+
+func (r *stubRows) Close() error { return nil }
+
+func (r *stubRows) Next(dest []driver.Value) error {
+	if r.i >= len(r.values) {
+		return io.EOF
+	}
+	dest[0] = r.values[r.i]
+	r.i++
+	return nil
+}
+
+func openStub(t *testing.T) *sql.DB {
+	db, err := sql.Open("timeutils-stub", t.Name())
+	assert.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	return db
+}
+
+func TestLocalDateTime_Scan(t *testing.T) {
+	db := openStub(t)
+	for _, v := range []any{
+		[]byte("2024-07-01 08:30:00"),
+		"2024-07-01 08:30:00.5",
+		time.Date(2024, 7, 1, 8, 30, 0, 0, time.UTC),
+		nil,
+		"0000-00-00 00:00:00",
+	} {
+		_, err := db.Exec("INSERT", v)
+		assert.NoError(t, err)
+	}
+
+	rows, err := db.Query("SELECT")
+	assert.NoError(t, err)
+	defer rows.Close()
+
+	var actual []string
+	for rows.Next() {
+		var d timeutilsgo.JakartaDateTime
+		assert.NoError(t, rows.Scan(&d))
+		if d.IsZero() {
+			actual = append(actual, "zero")
+			continue
+		}
+		actual = append(actual, d.UTC().Format(time.RFC3339Nano))
+	}
+	assert.NoError(t, rows.Err())
+	assert.Equal(t, []string{
+		"2024-07-01T01:30:00Z",
+		"2024-07-01T01:30:00.5Z",
+		"2024-07-01T01:30:00Z",
+		"zero",
+		"zero",
+	}, actual)
+}
+
+func TestLocalDateTime_RoundTrip(t *testing.T) {
+	db := openStub(t)
+	ts := parseRFC3339("2024-07-01T01:30:00Z")
+	_, err := db.Exec("INSERT", timeutilsgo.NewLocalDateTime[timeutilsgo.WITAZone](ts))
+	assert.NoError(t, err)
+	_, err = db.Exec("INSERT", timeutilsgo.LocalDateTime[timeutilsgo.WITAZone]{})
+	assert.NoError(t, err)
+	assert.Equal(t, []driver.Value{"2024-07-01 09:30:00", nil}, stub.rows[t.Name()])
+
+	rows, err := db.Query("SELECT")
+	assert.NoError(t, err)
+	defer rows.Close()
+
+	var actual []timeutilsgo.LocalDateTime[timeutilsgo.WITAZone]
+	for rows.Next() {
+		var d timeutilsgo.LocalDateTime[timeutilsgo.WITAZone]
+		assert.NoError(t, rows.Scan(&d))
+		actual = append(actual, d)
+	}
+	assert.Len(t, actual, 2)
+	assert.True(t, actual[0].Equal(ts))
+	assert.Equal(t, "2024-07-01 09:30:00 WITA", actual[0].Format("2006-01-02 15:04:05 MST"))
+	assert.True(t, actual[1].IsZero())
+}
+
+func TestLocalDateTime_Before1964(t *testing.T) {
+	// Jakarta was UTC+8 in 1950
+	ts := parseRFC3339("1950-01-01T00:00:00Z")
+	formatted, err := timeutilsgo.FormatMySQLDateJakartaTimezone(ts)
+	assert.NoError(t, err)
+	assert.Equal(t, "1950-01-01 08:00:00", formatted)
+
+	var d timeutilsgo.JakartaDateTime
+	assert.NoError(t, d.Scan(formatted))
+	assert.True(t, d.Equal(ts), d.String())
+
+	value, err := timeutilsgo.NewLocalDateTime[timeutilsgo.WIBZone](ts).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, formatted, value)
+}
+
+func TestLocalDateTime_Fraction(t *testing.T) {
+	for _, column := range []string{"2024-07-01 08:30:00.123", "2024-07-01 08:30:00.123456", "2024-07-01 08:30:00"} {
+		var d timeutilsgo.JakartaDateTime
+		assert.NoError(t, d.Scan([]byte(column)))
+		value, err := d.Value()
+		assert.NoError(t, err)
+		assert.Equal(t, column, value)
+	}
+}
+
+func TestLocalDateTime_ScanError(t *testing.T) {
+	var d timeutilsgo.JakartaDateTime
+	err := d.Scan("2024-07-01T08:30:00Z")
+	assert.ErrorIs(t, err, timeutilsgo.ErrInvalidDateTime)
+	var parseErr *timeutilsgo.ParseError
+	assert.ErrorAs(t, err, &parseErr)
+
+	assert.ErrorIs(t, d.Scan(int64(1719797400)), timeutilsgo.ErrInvalidDateTime)
+}
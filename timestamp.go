@@ -0,0 +1,153 @@
+package timeutils_go
+
+import (
+	"strconv"
+	"time"
+)
+
+// Codec text form of a Timestamp, implemented by empty marker types such as MySQLWIBCodec
+type Codec interface {
+	// EncodeTime text form of t, t is never the zero time
+	EncodeTime(t time.Time) string
+	// DecodeTime parse the text form, s is never empty
+	DecodeTime(s string) (time.Time, error)
+	// JSONString false when the JSON form is a bare number
+	JSONString() bool
+}
+
+// LayoutProvider time.Format layout of a LayoutCodec, implemented by empty marker types such as MySQLLayout
+type LayoutProvider interface {
+	Layout() string
+}
+
+// MySQLLayout "2006-01-02 15:04:05", the FormatMySQLDateJakartaTimezone layout
+type MySQLLayout struct{}
+
+func (MySQLLayout) Layout() string { return time.DateTime }
+
+// RFC3339Layout "2006-01-02T15:04:05Z07:00", any offset is accepted when decoding
+type RFC3339Layout struct{}
+
+func (RFC3339Layout) Layout() string { return time.RFC3339 }
+
+// DateLayout "2006-01-02", decoded as midnight of Z
+type DateLayout struct{}
+
+func (DateLayout) Layout() string { return time.DateOnly }
+
+// LayoutCodec the layout of L in the location of Z, e.g. LayoutCodec[MySQLLayout, WITAZone] for a Makassar DATETIME string.
+// A layout without offset is read as the wall clock of Z, decoded times are always in Z
+type LayoutCodec[L LayoutProvider, Z ZoneProvider] struct{}
+
+func (LayoutCodec[L, Z]) EncodeTime(t time.Time) string {
+	var l L
+	var z Z
+	return t.In(z.Location()).Format(l.Layout())
+}
+
+func (LayoutCodec[L, Z]) DecodeTime(s string) (time.Time, error) {
+	var l L
+	var z Z
+	t, err := time.ParseInLocation(l.Layout(), s, z.Location())
+	if err != nil {
+		return time.Time{}, newParseError(s, l.Layout(), ErrInvalidDateTime, err)
+	}
+	return t.In(z.Location()), nil
+}
+
+func (LayoutCodec[L, Z]) JSONString() bool { return true }
+
+// MySQLWIBCodec "2006-01-02 15:04:05" in Asia/Jakarta, the FormatMySQLDateJakartaTimezone layout
+type MySQLWIBCodec = LayoutCodec[MySQLLayout, WIBZone]
+
+// RFC3339WIBCodec RFC3339 in Asia/Jakarta, any offset is accepted when decoding
+type RFC3339WIBCodec = LayoutCodec[RFC3339Layout, WIBZone]
+
+// UnixSecondsCodec seconds since 1970-01-01 UTC, a JSON number
+type UnixSecondsCodec struct{}
+
+func (UnixSecondsCodec) EncodeTime(t time.Time) string {
+	return strconv.FormatInt(t.Unix(), 10)
+}
+
+func (UnixSecondsCodec) DecodeTime(s string) (time.Time, error) {
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return time.Time{}, newParseError(s, "unix seconds", ErrInvalidDateTime, err)
+	}
+	return time.Unix(n, 0).In(jakartaLocation), nil
+}
+
+func (UnixSecondsCodec) JSONString() bool { return false }
+
+// Timestamp time encoded by C in JSON, text and XML (elements and attributes go through MarshalText).
+// The zero time is JSON null and empty text, and decode back to the zero time
+type Timestamp[C Codec] struct {
+	time.Time
+}
+
+// JakartaTimestamp "2006-01-02 15:04:05" in Asia/Jakarta
+type JakartaTimestamp = Timestamp[MySQLWIBCodec]
+
+// UnixTimestamp epoch seconds
+type UnixTimestamp = Timestamp[UnixSecondsCodec]
+
+// NewTimestamp wrap t
+func NewTimestamp[C Codec](t time.Time) Timestamp[C] {
+	return Timestamp[C]{Time: t}
+}
+
+func (ts Timestamp[C]) String() string {
+	if ts.Time.IsZero() {
+		return ""
+	}
+	var c C
+	return c.EncodeTime(ts.Time)
+}
+
+func (ts Timestamp[C]) MarshalText() ([]byte, error) {
+	return []byte(ts.String()), nil
+}
+
+func (ts *Timestamp[C]) UnmarshalText(b []byte) error {
+	if len(b) == 0 {
+		ts.Time = time.Time{}
+		return nil
+	}
+	var c C
+	t, err := c.DecodeTime(string(b))
+	if err != nil {
+		return err
+	}
+	ts.Time = t
+	return nil
+}
+
+func (ts Timestamp[C]) MarshalJSON() ([]byte, error) {
+	if ts.Time.IsZero() {
+		return []byte("null"), nil
+	}
+	var c C
+	s := c.EncodeTime(ts.Time)
+	if c.JSONString() {
+		return strconv.AppendQuote(nil, s), nil
+	}
+	return []byte(s), nil
+}
+
+// UnmarshalJSON accept null, a JSON string and for a number codec a bare number
+func (ts *Timestamp[C]) UnmarshalJSON(b []byte) error {
+	s := string(b)
+	if s == "null" {
+		ts.Time = time.Time{}
+		return nil
+	}
+	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+		unquoted, err := strconv.Unquote(s)
+		if err != nil {
+			return newParseError(s, "JSON string", ErrInvalidDateTime, err)
+		}
+		s = unquoted
+	}
+	return ts.UnmarshalText([]byte(s))
+}
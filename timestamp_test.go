@@ -0,0 +1,125 @@
+package timeutils_go_test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+	"time"
+
+	timeutilsgo "github.com/harryosmar/timeutils-go"
+	"github.com/stretchr/testify/assert"
+)
+
+type shipment struct {
+	XMLName   xml.Name                                           `json:"-" xml:"shipment"`
+	CreatedAt timeutilsgo.JakartaTimestamp                       `json:"created_at" xml:"created_at,attr"`
+	PickedAt  timeutilsgo.Timestamp[timeutilsgo.RFC3339WIBCodec] `json:"picked_at" xml:"picked_at"`
+	Expiry    timeutilsgo.UnixTimestamp                          `json:"expiry" xml:"expiry"`
+	Cancelled timeutilsgo.Timestamp[timeutilsgo.MySQLWIBCodec]   `json:"cancelled" xml:"cancelled"`
+}
+
+func TestTimestamp_Marshal(t *testing.T) {
+	ts := parseRFC3339("2024-07-01T01:30:00Z")
+	s := shipment{
+		CreatedAt: timeutilsgo.NewTimestamp[timeutilsgo.MySQLWIBCodec](ts),
+		PickedAt:  timeutilsgo.NewTimestamp[timeutilsgo.RFC3339WIBCodec](ts),
+		Expiry:    timeutilsgo.NewTimestamp[timeutilsgo.UnixSecondsCodec](ts),
+	}
+
+	actual, err := json.Marshal(s)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"created_at":"2024-07-01 08:30:00","picked_at":"2024-07-01T08:30:00+07:00","expiry":1719797400,"cancelled":null}`, string(actual))
+
+	var back shipment
+	assert.NoError(t, json.Unmarshal(actual, &back))
+	assert.True(t, back.CreatedAt.Equal(ts))
+	assert.True(t, back.PickedAt.Equal(ts))
+	assert.True(t, back.Expiry.Equal(ts))
+	assert.True(t, back.Cancelled.IsZero())
+
+	actual, err = xml.Marshal(s)
+	assert.NoError(t, err)
+	assert.Equal(t, `<shipment created_at="2024-07-01 08:30:00"><picked_at>2024-07-01T08:30:00+07:00</picked_at><expiry>1719797400</expiry><cancelled></cancelled></shipment>`, string(actual))
+
+	back = shipment{}
+	assert.NoError(t, xml.Unmarshal(actual, &back))
+	assert.True(t, back.CreatedAt.Equal(ts))
+	assert.True(t, back.PickedAt.Equal(ts))
+	assert.True(t, back.Expiry.Equal(ts))
+	assert.True(t, back.Cancelled.IsZero())
+
+	text, err := s.CreatedAt.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-07-01 08:30:00", string(text))
+}
+
+func TestTimestamp_Unmarshal(t *testing.T) {
+	testData := []struct {
+		name           string
+		input          string
+		expectedResult string
+		expectedErr    error
+	}{
+		{name: "rfc3339 in another offset", input: `{"picked_at":"2024-07-01T03:30:00+02:00"}`, expectedResult: "2024-07-01T08:30:00+07:00"},
+		{name: "quoted unix seconds", input: `{"expiry":"1719797400"}`, expectedResult: "2024-07-01T08:30:00+07:00"},
+		{name: "invalid mysql layout", input: `{"created_at":"2024-07-01T08:30:00"}`, expectedErr: timeutilsgo.ErrInvalidDateTime},
+		{name: "invalid unix seconds", input: `{"expiry":1719797400.5}`, expectedErr: timeutilsgo.ErrInvalidDateTime},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			var s shipment
+			err := json.Unmarshal([]byte(tt.input), &s)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			actual := s.PickedAt.Time
+			if actual.IsZero() {
+				actual = s.Expiry.Time
+			}
+			assert.Equal(t, tt.expectedResult, actual.Format("2006-01-02T15:04:05-07:00"))
+		})
+	}
+}
+
+type flight struct {
+	DepartAt timeutilsgo.Timestamp[timeutilsgo.LayoutCodec[timeutilsgo.MySQLLayout, timeutilsgo.WITAZone]]  `json:"depart_at"`
+	ArriveAt timeutilsgo.Timestamp[timeutilsgo.LayoutCodec[timeutilsgo.RFC3339Layout, timeutilsgo.UTCZone]] `json:"arrive_at"`
+	Date     timeutilsgo.Timestamp[timeutilsgo.LayoutCodec[timeutilsgo.DateLayout, timeutilsgo.WITZone]]    `json:"date"`
+}
+
+func TestTimestamp_LayoutCodec(t *testing.T) {
+	ts := parseRFC3339("2024-07-01T17:30:00Z")
+	f := flight{
+		DepartAt: timeutilsgo.NewTimestamp[timeutilsgo.LayoutCodec[timeutilsgo.MySQLLayout, timeutilsgo.WITAZone]](ts),
+		ArriveAt: timeutilsgo.NewTimestamp[timeutilsgo.LayoutCodec[timeutilsgo.RFC3339Layout, timeutilsgo.UTCZone]](ts),
+		Date:     timeutilsgo.NewTimestamp[timeutilsgo.LayoutCodec[timeutilsgo.DateLayout, timeutilsgo.WITZone]](ts),
+	}
+
+	actual, err := json.Marshal(f)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"depart_at":"2024-07-02 01:30:00","arrive_at":"2024-07-01T17:30:00Z","date":"2024-07-02"}`, string(actual))
+
+	var back flight
+	assert.NoError(t, json.Unmarshal(actual, &back))
+	assert.True(t, back.DepartAt.Equal(ts))
+	assert.True(t, back.ArriveAt.Equal(ts))
+	assert.Equal(t, "2024-07-02T00:00:00+09:00", back.Date.Format(time.RFC3339))
+
+	var invalid flight
+	assert.ErrorIs(t, json.Unmarshal([]byte(`{"date":"2024-07-02 01:30:00"}`), &invalid), timeutilsgo.ErrInvalidDateTime)
+}
+
+func TestTimestamp_Before1964(t *testing.T) {
+	// Asia/Jakarta was UTC+8 in 1950, the same wall clock as FormatMySQLDateJakartaTimezone
+	ts := parseRFC3339("1950-01-01T00:00:00Z")
+	actual, err := json.Marshal(timeutilsgo.NewTimestamp[timeutilsgo.MySQLWIBCodec](ts))
+	assert.NoError(t, err)
+	assert.Equal(t, `"1950-01-01 08:00:00"`, string(actual))
+
+	var back timeutilsgo.JakartaTimestamp
+	assert.NoError(t, json.Unmarshal(actual, &back))
+	assert.True(t, back.Equal(ts))
+}
@@ -0,0 +1,38 @@
+package timeutils_go
+
+import (
+	"time"
+)
+
+// Floor truncate t to the start of u in loc, weeks start on Monday, loc default to Asia/Jakarta
+func Floor(t time.Time, u Unit, loc *time.Location) time.Time {
+	return floorUnit(t, u, locationOrDefault(loc))
+}
+
+// Ceil the smallest start of u in loc that is not before t
+func Ceil(t time.Time, u Unit, loc *time.Location) time.Time {
+	loc = locationOrDefault(loc)
+	floor := floorUnit(t, u, loc)
+	if floor.Equal(t) {
+		return floor
+	}
+	return addUnits(floor, u, 1, loc)
+}
+
+// Round the nearest start of u in loc, half way rounds up
+func Round(t time.Time, u Unit, loc *time.Location) time.Time {
+	loc = locationOrDefault(loc)
+	floor := floorUnit(t, u, loc)
+	ceil := addUnits(floor, u, 1, loc)
+	if t.Sub(floor) < ceil.Sub(t) {
+		return floor
+	}
+	return ceil
+}
+
+// FloorAdd floor t to u in loc then add n units,
+// PlusHourToTime is FloorAdd(t, Hour, n, time.UTC) and FloorDay is FloorAdd(t, Day, DRange, Asia/Jakarta)
+func FloorAdd(t time.Time, u Unit, n int, loc *time.Location) time.Time {
+	loc = locationOrDefault(loc)
+	return addUnits(floorUnit(t, u, loc), u, n, loc)
+}
@@ -0,0 +1,100 @@
+package timeutils_go_test
+
+import (
+	"testing"
+	"time"
+
+	timeutilsgo "github.com/harryosmar/timeutils-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFloorCeilRound(t *testing.T) {
+	type args struct {
+		t    time.Time
+		unit timeutilsgo.Unit
+	}
+	testData := []struct {
+		name          string
+		args          args
+		expectedFloor string
+		expectedCeil  string
+		expectedRound string
+	}{
+		{
+			name:          "minute",
+			args:          args{t: parseRFC3339("2023-03-28T10:15:30+07:00"), unit: timeutilsgo.Minute},
+			expectedFloor: "2023-03-28T10:15:00+07:00",
+			expectedCeil:  "2023-03-28T10:16:00+07:00",
+			expectedRound: "2023-03-28T10:16:00+07:00",
+		},
+		{
+			name:          "hour",
+			args:          args{t: parseRFC3339("2023-03-28T10:15:30+07:00"), unit: timeutilsgo.Hour},
+			expectedFloor: "2023-03-28T10:00:00+07:00",
+			expectedCeil:  "2023-03-28T11:00:00+07:00",
+			expectedRound: "2023-03-28T10:00:00+07:00",
+		},
+		{
+			name:          "day already floored",
+			args:          args{t: parseRFC3339("2023-03-28T00:00:00+07:00"), unit: timeutilsgo.Day},
+			expectedFloor: "2023-03-28T00:00:00+07:00",
+			expectedCeil:  "2023-03-28T00:00:00+07:00",
+			expectedRound: "2023-03-28T00:00:00+07:00",
+		},
+		{
+			name:          "day use jakarta boundary",
+			args:          args{t: parseRFC3339("2023-03-28T18:00:00Z"), unit: timeutilsgo.Day},
+			expectedFloor: "2023-03-29T00:00:00+07:00",
+			expectedCeil:  "2023-03-30T00:00:00+07:00",
+			expectedRound: "2023-03-29T00:00:00+07:00",
+		},
+		{
+			name:          "week",
+			args:          args{t: parseRFC3339("2023-03-30T10:00:00+07:00"), unit: timeutilsgo.Week},
+			expectedFloor: "2023-03-27T00:00:00+07:00",
+			expectedCeil:  "2023-04-03T00:00:00+07:00",
+			expectedRound: "2023-03-27T00:00:00+07:00",
+		},
+		{
+			name:          "month",
+			args:          args{t: parseRFC3339("2023-02-15T00:00:00+07:00"), unit: timeutilsgo.Month},
+			expectedFloor: "2023-02-01T00:00:00+07:00",
+			expectedCeil:  "2023-03-01T00:00:00+07:00",
+			expectedRound: "2023-03-01T00:00:00+07:00",
+		},
+		{
+			name:          "year",
+			args:          args{t: parseRFC3339("2023-03-28T00:00:00+07:00"), unit: timeutilsgo.Year},
+			expectedFloor: "2023-01-01T00:00:00+07:00",
+			expectedCeil:  "2024-01-01T00:00:00+07:00",
+			expectedRound: "2023-01-01T00:00:00+07:00",
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedFloor, timeutilsgo.Floor(tt.args.t, tt.args.unit, nil).Format(time.RFC3339))
+			assert.Equal(t, tt.expectedCeil, timeutilsgo.Ceil(tt.args.t, tt.args.unit, nil).Format(time.RFC3339))
+			assert.Equal(t, tt.expectedRound, timeutilsgo.Round(tt.args.t, tt.args.unit, nil).Format(time.RFC3339))
+		})
+	}
+}
+
+func TestFloorAdd(t *testing.T) {
+	jakarta := time.FixedZone("WIB", 7*3600)
+	kolkata := time.FixedZone("IST", 5*3600+1800)
+	base := parseRFC3339("2020-03-19T00:59:59+07:00")
+
+	for _, n := range []int{-30, -1, 0, 1, 2, 48} {
+		assert.Equal(t, timeutilsgo.PlusHourToTime(base, int64(n)).Unix(), timeutilsgo.FloorAdd(base, timeutilsgo.Hour, n, time.UTC).Unix())
+		floorDay, err := timeutilsgo.FloorDay(timeutilsgo.FloorDayParam{T: base, DRange: n})
+		assert.NoError(t, err)
+		assert.Equal(t, floorDay.Unix(), timeutilsgo.FloorAdd(base, timeutilsgo.Day, n, jakarta).Unix())
+	}
+
+	// PlusHourToTime floor to the UTC hour, not the local one
+	half := parseRFC3339("2020-03-19T10:45:00+05:30")
+	assert.Equal(t, "2020-03-19T11:30:00+05:30", timeutilsgo.FloorAdd(half, timeutilsgo.Hour, 1, time.UTC).In(kolkata).Format(time.RFC3339))
+	assert.Equal(t, "2020-03-19T11:00:00+05:30", timeutilsgo.FloorAdd(half, timeutilsgo.Hour, 1, kolkata).Format(time.RFC3339))
+	assert.Equal(t, "2020-05-01T00:00:00+07:00", timeutilsgo.FloorAdd(base, timeutilsgo.Month, 2, nil).Format(time.RFC3339))
+}
@@ -0,0 +1,12 @@
+// Package tzdata embeds the IANA time zone database in the binary, about 450 KB.
+// Import it for the side effect in main packages that run on images without /usr/share/zoneinfo,
+// e.g. distroless, so Format and LoadLocation resolve every zone:
+//
+//	import _ "github.com/harryosmar/timeutils-go/tzdata"
+//
+// The same can be done without an import by building with -tags timetzdata
+package tzdata
+
+import (
+	_ "time/tzdata"
+)
@@ -0,0 +1,34 @@
+package tzdata_test
+
+import (
+	"testing"
+	"time"
+
+	timeutilsgo "github.com/harryosmar/timeutils-go"
+	_ "github.com/harryosmar/timeutils-go/tzdata"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestFormat_WithTZDataImport the IANA names load with the package imported. It cannot tell the embedded copy
+// from the system one since time.LoadLocation read /usr/share/zoneinfo before the embedded tzdata,
+// the behaviour without any tzdata is covered by TestLoadLocation_Fallback in the root package
+func TestFormat_WithTZDataImport(t *testing.T) {
+	ts := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
+	testData := []struct {
+		location       string
+		expectedResult string
+	}{
+		{location: "Asia/Jakarta", expectedResult: "2024-07-01T07:00:00+07:00 WIB"},
+		{location: "Asia/Makassar", expectedResult: "2024-07-01T08:00:00+08:00 WITA"},
+		{location: "Asia/Jayapura", expectedResult: "2024-07-01T09:00:00+09:00 WIT"},
+		{location: "America/New_York", expectedResult: "2024-06-30T20:00:00-04:00 EDT"},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.location, func(t *testing.T) {
+			actual, err := timeutilsgo.Format(timeutilsgo.FormatParam{T: ts, Location: tt.location, Format: time.RFC3339 + " MST"})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, actual)
+		})
+	}
+}
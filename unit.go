@@ -0,0 +1,84 @@
+package timeutils_go
+
+import (
+	"time"
+)
+
+// Unit is a calendar unit used by the iterator and truncation helpers, the zero value is not a unit
+type Unit int
+
+const (
+	Second Unit = iota + 1
+	Minute
+	Hour
+	Day
+	Week
+	Month
+	Year
+)
+
+var unitNames = [...]string{"", "second", "minute", "hour", "day", "week", "month", "year"}
+
+func (u Unit) String() string {
+	if u < Second || u > Year {
+		return "unknown"
+	}
+	return unitNames[u]
+}
+
+// jakartaLocation Asia/Jakarta has no DST, so a fixed +07:00 zone is enough
+var jakartaLocation = time.FixedZone("WIB", 7*3600)
+
+func locationOrDefault(loc *time.Location) *time.Location {
+	if loc == nil {
+		return jakartaLocation
+	}
+	return loc
+}
+
+// floorUnit truncate t to the start of its unit in loc, weeks start on Monday.
+// Second, minute and hour subtract the elapsed wall clock so an overlap keep the occurrence of t
+func floorUnit(t time.Time, u Unit, loc *time.Location) time.Time {
+	t = t.In(loc)
+	year, month, day := t.Date()
+	switch u {
+	case Second:
+		return t.Add(-time.Duration(t.Nanosecond()))
+	case Minute:
+		return t.Add(-time.Duration(t.Second())*time.Second - time.Duration(t.Nanosecond()))
+	case Hour:
+		return t.Add(-time.Duration(t.Minute())*time.Minute - time.Duration(t.Second())*time.Second - time.Duration(t.Nanosecond()))
+	case Day:
+		return wallTime(year, month, day, 0, 0, 0, 0, loc)
+	case Week:
+		return dayStart(weekStartDay(nthDayIn(t, loc), time.Monday), loc)
+	case Month:
+		return wallTime(year, month, 1, 0, 0, 0, 0, loc)
+	case Year:
+		return wallTime(year, time.January, 1, 0, 0, 0, 0, loc)
+	}
+	return t
+}
+
+// addUnits add n units to t, second/minute/hour are exact while day and above follow the wall clock in loc
+func addUnits(t time.Time, u Unit, n int, loc *time.Location) time.Time {
+	switch u {
+	case Second, Minute, Hour:
+		return time.Unix(t.Unix()+int64(n)*unitSeconds[u], int64(t.Nanosecond())).In(t.Location())
+	}
+
+	t = t.In(loc)
+	year, month, day := t.Date()
+	hour, minute, sec := t.Clock()
+	switch u {
+	case Day:
+		day += n
+	case Week:
+		day += 7 * n
+	case Month:
+		month += time.Month(n)
+	case Year:
+		year += n
+	}
+	return wallTime(year, month, day, hour, minute, sec, t.Nanosecond(), loc)
+}
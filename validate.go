@@ -0,0 +1,108 @@
+package timeutils_go
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Validation bounds applied to user supplied date parts, years outside MinYear..MaxYear are rejected
+type Validation struct {
+	MinYear int
+	MaxYear int
+}
+
+// DefaultValidation used by GetMonthRange, CombineDateAndHour and the other functions taking date parts
+var DefaultValidation = Validation{
+	MinYear: 1,
+	MaxYear: 9999,
+}
+
+func (v Validation) Year(year int) error {
+	if year < v.MinYear || year > v.MaxYear {
+		return fmt.Errorf("%w: %d is outside %d..%d", ErrInvalidYear, year, v.MinYear, v.MaxYear)
+	}
+	return nil
+}
+
+func (v Validation) Month(month int) error {
+	if month < 1 || month > 12 {
+		return fmt.Errorf("%w: %d is outside 1..12", ErrInvalidMonth, month)
+	}
+	return nil
+}
+
+// Date validate year, month and that day exist in that month
+func (v Validation) Date(year int, month int, day int) error {
+	if err := v.Year(year); err != nil {
+		return err
+	}
+	if err := v.Month(month); err != nil {
+		return err
+	}
+	if last := daysInMonth(year, time.Month(month)); day < 1 || day > last {
+		return fmt.Errorf("%w: %d is outside 1..%d for %d-%02d", ErrInvalidDay, day, last, year, month)
+	}
+	return nil
+}
+
+// TimeOfDay strictly parse "HH:MM:SS" from "00:00:00" to "23:59:59"
+func (v Validation) TimeOfDay(s string) (hour int, minute int, second int, err error) {
+	if len(s) != 8 || s[2] != ':' || s[5] != ':' {
+		return 0, 0, 0, newParseError(s, "15:04:05", ErrInvalidTimeOfDay, errors.New("expected HH:MM:SS"))
+	}
+
+	parts := [3]int{}
+	for i := range parts {
+		hi, lo := s[i*3], s[i*3+1]
+		if hi < '0' || hi > '9' || lo < '0' || lo > '9' {
+			return 0, 0, 0, newParseError(s, "15:04:05", ErrInvalidTimeOfDay, errors.New("expected digits"))
+		}
+		parts[i] = int(hi-'0')*10 + int(lo-'0')
+	}
+
+	hour, minute, second = parts[0], parts[1], parts[2]
+	if hour > 23 {
+		return 0, 0, 0, newParseError(s, "15:04:05", ErrInvalidTimeOfDay, fmt.Errorf("hour %d is outside 00..23", hour))
+	}
+	if minute > 59 {
+		return 0, 0, 0, newParseError(s, "15:04:05", ErrInvalidTimeOfDay, fmt.Errorf("minute %d is outside 00..59", minute))
+	}
+	if second > 59 {
+		return 0, 0, 0, newParseError(s, "15:04:05", ErrInvalidTimeOfDay, fmt.Errorf("second %d is outside 00..59", second))
+	}
+	return hour, minute, second, nil
+}
+
+// GetMonthRange same as the package GetMonthRange with v bounds
+func (v Validation) GetMonthRange(month int, year int) (gte int64, lte int64, err error) {
+	if err := v.Month(month); err != nil {
+		return 0, 0, err
+	}
+	if err := v.Year(year); err != nil {
+		return 0, 0, err
+	}
+
+	gte, lte = monthsRange(year, time.Month(month), 1, jakartaLocation)
+	return gte, lte, nil
+}
+
+// CombineDateAndHour same as the package CombineDateAndHour with v strict time of day check
+func (v Validation) CombineDateAndHour(d time.Time, hourStr string) (time.Time, error) {
+	hour, minute, second, err := v.TimeOfDay(hourStr)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(d.Unix()+int64(hour*3600+minute*60+second), 0), nil
+}
+
+// CombineDateAndHourIn same as the package CombineDateAndHourIn with v strict time of day check
+func (v Validation) CombineDateAndHourIn(d time.Time, hourStr string, loc *time.Location, policy CivilPolicy) (time.Time, error) {
+	hour, minute, second, err := v.TimeOfDay(hourStr)
+	if err != nil {
+		return time.Time{}, err
+	}
+	loc = locationOrDefault(loc)
+	year, month, day := d.In(loc).Date()
+	return resolveWall(time.Date(year, month, day, hour, minute, second, 0, time.UTC), loc, policy)
+}
@@ -0,0 +1,140 @@
+package timeutils_go_test
+
+import (
+	"testing"
+	"time"
+
+	timeutilsgo "github.com/harryosmar/timeutils-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetMonthRangeValidation(t *testing.T) {
+	testData := []struct {
+		name        string
+		validation  timeutilsgo.Validation
+		month       int
+		year        int
+		expectedErr error
+	}{
+		{
+			name:        "month zero",
+			validation:  timeutilsgo.DefaultValidation,
+			month:       0,
+			year:        2023,
+			expectedErr: timeutilsgo.ErrInvalidMonth,
+		},
+		{
+			name:        "month thirteen",
+			validation:  timeutilsgo.DefaultValidation,
+			month:       13,
+			year:        2023,
+			expectedErr: timeutilsgo.ErrInvalidMonth,
+		},
+		{
+			name:        "negative year",
+			validation:  timeutilsgo.DefaultValidation,
+			month:       1,
+			year:        -1,
+			expectedErr: timeutilsgo.ErrInvalidYear,
+		},
+		{
+			name:        "year zero",
+			validation:  timeutilsgo.DefaultValidation,
+			month:       1,
+			year:        0,
+			expectedErr: timeutilsgo.ErrInvalidYear,
+		},
+		{
+			name:        "five digit year",
+			validation:  timeutilsgo.DefaultValidation,
+			month:       1,
+			year:        10000,
+			expectedErr: timeutilsgo.ErrInvalidYear,
+		},
+		{
+			name:        "custom range",
+			validation:  timeutilsgo.Validation{MinYear: 2000, MaxYear: 2100},
+			month:       1,
+			year:        1999,
+			expectedErr: timeutilsgo.ErrInvalidYear,
+		},
+		{
+			name:       "last valid year",
+			validation: timeutilsgo.DefaultValidation,
+			month:      12,
+			year:       9999,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			gte, lte, err := tt.validation.GetMonthRange(tt.month, tt.year)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, int64(86400*31-1), lte-gte)
+		})
+	}
+}
+
+func TestTimeOfDayValidation(t *testing.T) {
+	testData := []struct {
+		name        string
+		input       string
+		expectedErr bool
+	}{
+		{name: "midnight", input: "00:00:00"},
+		{name: "last second", input: "23:59:59"},
+		{name: "single digit hour", input: "1:00:00", expectedErr: true},
+		{name: "missing seconds", input: "23:00", expectedErr: true},
+		{name: "fraction", input: "23:00:00.5", expectedErr: true},
+		{name: "offset", input: "23:00:00+07:00", expectedErr: true},
+		{name: "hour 24", input: "24:00:00", expectedErr: true},
+		{name: "minute 60", input: "23:60:00", expectedErr: true},
+		{name: "second 60", input: "23:59:60", expectedErr: true},
+		{name: "letters", input: "ab:cd:ef", expectedErr: true},
+		{name: "wrong separator", input: "23.00.00", expectedErr: true},
+		{name: "empty", input: "", expectedErr: true},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := timeutilsgo.CombineDateAndHour(time.Unix(0, 0), tt.input)
+			if tt.expectedErr {
+				assert.ErrorIs(t, err, timeutilsgo.ErrInvalidTimeOfDay)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestDateValidation(t *testing.T) {
+	testData := []struct {
+		name        string
+		year        int
+		month       int
+		day         int
+		expectedErr error
+	}{
+		{name: "leap day", year: 2024, month: 2, day: 29},
+		{name: "not leap day", year: 2023, month: 2, day: 29, expectedErr: timeutilsgo.ErrInvalidDay},
+		{name: "day zero", year: 2023, month: 1, day: 0, expectedErr: timeutilsgo.ErrInvalidDay},
+		{name: "day 31 in april", year: 2023, month: 4, day: 31, expectedErr: timeutilsgo.ErrInvalidDay},
+		{name: "invalid month", year: 2023, month: 13, day: 1, expectedErr: timeutilsgo.ErrInvalidMonth},
+		{name: "invalid year", year: 0, month: 1, day: 1, expectedErr: timeutilsgo.ErrInvalidYear},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := timeutilsgo.DefaultValidation.Date(tt.year, tt.month, tt.day)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package timeutils_go
+
+import (
+	"time"
+)
+
+// weekIndex number of weeks starting on weekStart since the week containing 1970-01-01,
+// day is a day index like NthDay and day 0 is a Thursday
+func weekIndex(day int, weekStart time.Weekday) int {
+	return floorDiv(day+int(time.Thursday)-int(weekStart), 7)
+}
+
+// weekStartDay day index of the first day of the week containing day
+func weekStartDay(day int, weekStart time.Weekday) int {
+	return day - floorMod(day+int(time.Thursday)-int(weekStart), 7)
+}
+
+// dayStart midnight in loc of the day index day
+func dayStart(day int, loc *time.Location) time.Time {
+	return wallTime(1970, time.January, 1+day, 0, 0, 0, 0, loc)
+}
+
+// StartOfWeek midnight of the first day of the week containing t in loc, loc default to Asia/Jakarta
+func StartOfWeek(t time.Time, loc *time.Location, weekStart time.Weekday) time.Time {
+	loc = locationOrDefault(loc)
+	return dayStart(weekStartDay(nthDayIn(t, loc), weekStart), loc)
+}
+
+// EndOfWeek last second of the week containing t in loc
+func EndOfWeek(t time.Time, loc *time.Location, weekStart time.Weekday) time.Time {
+	loc = locationOrDefault(loc)
+	return dayStart(weekStartDay(nthDayIn(t, loc), weekStart)+7, loc).Add(-time.Second)
+}
+
+// WeekRange start and last second of the week containing t in loc,
+// e.g. WeekRange(t, WIB, time.Monday) for a leaderboard resetting Monday 00:00 WIB
+func WeekRange(t time.Time, loc *time.Location, weekStart time.Weekday) TimeRange {
+	return TimeRange{
+		Start: StartOfWeek(t, loc, weekStart),
+		End:   EndOfWeek(t, loc, weekStart),
+	}
+}
+
+// ISOWeek ISO 8601 week-year and week number of t in loc
+func ISOWeek(t time.Time, loc *time.Location) (year int, week int) {
+	return t.In(locationOrDefault(loc)).ISOWeek()
+}
+
+// WeeksBetween number of week boundaries in loc from t1 to t2, the week equivalent of DaysBetween
+func WeeksBetween(t1 time.Time, t2 time.Time, loc *time.Location, weekStart time.Weekday) int {
+	loc = locationOrDefault(loc)
+	return weekIndex(nthDayIn(t2, loc), weekStart) - weekIndex(nthDayIn(t1, loc), weekStart)
+}
+
+// InWeekRange the week equivalent of InDayRange, check WeeksBetween(t, now) against minW and maxW
+func InWeekRange(t time.Time, now time.Time, loc *time.Location, weekStart time.Weekday, minW Range, maxW Range) bool {
+	return inRange(float64(WeeksBetween(t, now, loc, weekStart)), minW, maxW)
+}
@@ -0,0 +1,99 @@
+package timeutils_go_test
+
+import (
+	"testing"
+	"time"
+
+	timeutilsgo "github.com/harryosmar/timeutils-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWeekRange(t *testing.T) {
+	type args struct {
+		t         time.Time
+		loc       *time.Location
+		weekStart time.Weekday
+	}
+	testData := []struct {
+		name          string
+		args          args
+		expectedStart string
+		expectedEnd   string
+	}{
+		{
+			name:          "monday start in WIB",
+			args:          args{t: parseRFC3339("2023-03-29T10:00:00+07:00"), weekStart: time.Monday},
+			expectedStart: "2023-03-27T00:00:00+07:00",
+			expectedEnd:   "2023-04-02T23:59:59+07:00",
+		},
+		{
+			name:          "monday 00:00 WIB is a new week",
+			args:          args{t: parseRFC3339("2023-04-03T00:00:00+07:00"), weekStart: time.Monday},
+			expectedStart: "2023-04-03T00:00:00+07:00",
+			expectedEnd:   "2023-04-09T23:59:59+07:00",
+		},
+		{
+			name:          "sunday 23:59 UTC is already monday in WIB",
+			args:          args{t: parseRFC3339("2023-04-02T23:59:00Z"), weekStart: time.Monday},
+			expectedStart: "2023-04-03T00:00:00+07:00",
+			expectedEnd:   "2023-04-09T23:59:59+07:00",
+		},
+		{
+			name:          "sunday start",
+			args:          args{t: parseRFC3339("2023-04-02T10:00:00-05:00"), loc: time.FixedZone("EST", -5*3600), weekStart: time.Sunday},
+			expectedStart: "2023-04-02T00:00:00-05:00",
+			expectedEnd:   "2023-04-08T23:59:59-05:00",
+		},
+		{
+			name:          "before 1970",
+			args:          args{t: parseRFC3339("1969-12-31T10:00:00+07:00"), weekStart: time.Monday},
+			expectedStart: "1969-12-29T00:00:00+07:00",
+			expectedEnd:   "1970-01-04T23:59:59+07:00",
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := timeutilsgo.WeekRange(tt.args.t, tt.args.loc, tt.args.weekStart)
+			assert.Equal(t, tt.expectedStart, actual.Start.Format(time.RFC3339))
+			assert.Equal(t, tt.expectedEnd, actual.End.Format(time.RFC3339))
+		})
+	}
+}
+
+func TestISOWeek(t *testing.T) {
+	testData := []struct {
+		name         string
+		t            time.Time
+		expectedYear int
+		expectedWeek int
+	}{
+		{name: "first week", t: parseRFC3339("2023-01-02T00:00:00+07:00"), expectedYear: 2023, expectedWeek: 1},
+		{name: "belongs to previous year", t: parseRFC3339("2023-01-01T00:00:00+07:00"), expectedYear: 2022, expectedWeek: 52},
+		{name: "53 weeks year", t: parseRFC3339("2020-12-31T00:00:00+07:00"), expectedYear: 2020, expectedWeek: 53},
+		{name: "use jakarta calendar day", t: parseRFC3339("2023-01-01T17:00:00Z"), expectedYear: 2023, expectedWeek: 1},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			year, week := timeutilsgo.ISOWeek(tt.t, nil)
+			assert.Equal(t, tt.expectedYear, year)
+			assert.Equal(t, tt.expectedWeek, week)
+		})
+	}
+}
+
+func TestWeeksBetween(t *testing.T) {
+	sunday := parseRFC3339("2023-04-02T10:00:00+07:00")
+	monday := parseRFC3339("2023-04-03T10:00:00+07:00")
+	nextSunday := parseRFC3339("2023-04-09T10:00:00+07:00")
+
+	assert.Equal(t, 1, timeutilsgo.WeeksBetween(sunday, monday, nil, time.Monday))
+	assert.Equal(t, 0, timeutilsgo.WeeksBetween(sunday, monday, nil, time.Sunday))
+	assert.Equal(t, 1, timeutilsgo.WeeksBetween(sunday, nextSunday, nil, time.Sunday))
+	assert.Equal(t, -1, timeutilsgo.WeeksBetween(nextSunday, sunday, nil, time.Monday))
+
+	thisWeek := timeutilsgo.Range{Value: 0, IsEqual: true}
+	assert.True(t, timeutilsgo.InWeekRange(sunday, monday, nil, time.Sunday, thisWeek, thisWeek))
+	assert.False(t, timeutilsgo.InWeekRange(sunday, monday, nil, time.Monday, thisWeek, thisWeek))
+}
@@ -0,0 +1,75 @@
+package timeutils_go
+
+import (
+	"time"
+)
+
+// ZoneInfo zone in effect at an instant, Start and End are the surrounding transitions,
+// zero when the zone has no transition before or after
+type ZoneInfo struct {
+	// Name abbreviation, e.g. "WIB", "EST", "EDT"
+	Name string
+	// Offset seconds east of UTC
+	Offset int
+	IsDST  bool
+	Start  time.Time
+	End    time.Time
+}
+
+// Transition change of zone at At, Before is in effect until At and After from At
+type Transition struct {
+	At     time.Time
+	Before ZoneInfo
+	After  ZoneInfo
+}
+
+// ZoneAt zone of loc in effect at t, loc default to Asia/Jakarta
+func ZoneAt(t time.Time, loc *time.Location) ZoneInfo {
+	loc = locationOrDefault(loc)
+	t = t.In(loc)
+	name, offset := t.Zone()
+	start, end := t.ZoneBounds()
+	if !start.IsZero() {
+		start = start.In(loc)
+	}
+	if !end.IsZero() {
+		end = end.In(loc)
+	}
+	return ZoneInfo{
+		Name:   name,
+		Offset: offset,
+		IsDST:  t.IsDST(),
+		Start:  start,
+		End:    end,
+	}
+}
+
+// Transitions zone changes of loc in (from, to], a tzdata entry that keep the same name, offset and DST flag is skipped
+func Transitions(loc *time.Location, from time.Time, to time.Time) []Transition {
+	var transitions []Transition
+	zone := ZoneAt(from, loc)
+	for {
+		transition, ok := nextTransition(zone, loc)
+		if !ok || transition.At.After(to) {
+			return transitions
+		}
+		transitions = append(transitions, transition)
+		zone = transition.After
+	}
+}
+
+// NextTransition first zone change of loc after t, false when loc has no later transition
+func NextTransition(t time.Time, loc *time.Location) (Transition, bool) {
+	return nextTransition(ZoneAt(t, loc), loc)
+}
+
+func nextTransition(zone ZoneInfo, loc *time.Location) (Transition, bool) {
+	for !zone.End.IsZero() {
+		next := ZoneAt(zone.End, loc)
+		if next.Name != zone.Name || next.Offset != zone.Offset || next.IsDST != zone.IsDST {
+			return Transition{At: zone.End, Before: zone, After: next}, true
+		}
+		zone = next
+	}
+	return Transition{}, false
+}
@@ -0,0 +1,115 @@
+package timeutils_go_test
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata"
+
+	timeutilsgo "github.com/harryosmar/timeutils-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZoneAt(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+	jakarta, err := time.LoadLocation("Asia/Jakarta")
+	assert.NoError(t, err)
+
+	testData := []struct {
+		name           string
+		t              time.Time
+		loc            *time.Location
+		expectedName   string
+		expectedOffset int
+		expectedIsDST  bool
+		expectedStart  string
+		expectedEnd    string
+	}{
+		{
+			name:           "new york summer",
+			t:              parseRFC3339("2024-07-01T00:00:00Z"),
+			loc:            newYork,
+			expectedName:   "EDT",
+			expectedOffset: -4 * 3600,
+			expectedIsDST:  true,
+			expectedStart:  "2024-03-10T03:00:00-04:00",
+			expectedEnd:    "2024-11-03T01:00:00-05:00",
+		},
+		{
+			name:           "new york winter",
+			t:              parseRFC3339("2024-12-01T00:00:00Z"),
+			loc:            newYork,
+			expectedName:   "EST",
+			expectedOffset: -5 * 3600,
+			expectedStart:  "2024-11-03T01:00:00-05:00",
+			expectedEnd:    "2025-03-09T03:00:00-04:00",
+		},
+		{
+			name:           "jakarta",
+			t:              parseRFC3339("2024-07-01T00:00:00Z"),
+			loc:            jakarta,
+			expectedName:   "WIB",
+			expectedOffset: 7 * 3600,
+			expectedStart:  "1963-12-31T23:30:00+07:00",
+			expectedEnd:    "0001-01-01T00:00:00Z",
+		},
+		{
+			name:           "default fixed zone",
+			t:              parseRFC3339("2024-07-01T00:00:00Z"),
+			expectedName:   "WIB",
+			expectedOffset: 7 * 3600,
+			expectedStart:  "0001-01-01T00:00:00Z",
+			expectedEnd:    "0001-01-01T00:00:00Z",
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := timeutilsgo.ZoneAt(tt.t, tt.loc)
+			assert.Equal(t, tt.expectedName, actual.Name)
+			assert.Equal(t, tt.expectedOffset, actual.Offset)
+			assert.Equal(t, tt.expectedIsDST, actual.IsDST)
+			assert.Equal(t, tt.expectedStart, actual.Start.Format(time.RFC3339))
+			assert.Equal(t, tt.expectedEnd, actual.End.Format(time.RFC3339))
+		})
+	}
+}
+
+func TestTransitions(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+
+	actual := timeutilsgo.Transitions(newYork, parseRFC3339("2024-01-01T00:00:00Z"), parseRFC3339("2025-03-09T07:00:00Z"))
+	assert.Len(t, actual, 3)
+	assert.Equal(t, []string{
+		"2024-03-10T07:00:00Z EST -> EDT",
+		"2024-11-03T06:00:00Z EDT -> EST",
+		"2025-03-09T07:00:00Z EST -> EDT",
+	}, []string{
+		actual[0].At.UTC().Format(time.RFC3339) + " " + actual[0].Before.Name + " -> " + actual[0].After.Name,
+		actual[1].At.UTC().Format(time.RFC3339) + " " + actual[1].Before.Name + " -> " + actual[1].After.Name,
+		actual[2].At.UTC().Format(time.RFC3339) + " " + actual[2].Before.Name + " -> " + actual[2].After.Name,
+	})
+	assert.Equal(t, 3600, actual[0].After.Offset-actual[0].Before.Offset)
+
+	jakarta, err := time.LoadLocation("Asia/Jakarta")
+	assert.NoError(t, err)
+	actual = timeutilsgo.Transitions(jakarta, parseRFC3339("1950-01-01T00:00:00Z"), parseRFC3339("2024-01-01T00:00:00Z"))
+	assert.Len(t, actual, 2)
+	assert.Equal(t, "WIB", actual[1].After.Name)
+	assert.Equal(t, -30*60, actual[1].After.Offset-actual[1].Before.Offset)
+}
+
+func TestNextTransition(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+
+	actual, ok := timeutilsgo.NextTransition(parseRFC3339("2024-07-01T00:00:00Z"), newYork)
+	assert.True(t, ok)
+	assert.Equal(t, "2024-11-03T01:00:00-05:00", actual.At.Format(time.RFC3339))
+	assert.Equal(t, "EDT", actual.Before.Name)
+	assert.Equal(t, "EST", actual.After.Name)
+
+	_, ok = timeutilsgo.NextTransition(parseRFC3339("2024-07-01T00:00:00Z"), nil)
+	assert.False(t, ok)
+}